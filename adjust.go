@@ -0,0 +1,59 @@
+// Copyright ©2026 The bíogo.nn Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mnist
+
+import (
+	"math"
+	"math/rand"
+)
+
+// RandomBrightness returns a copy of the data set where each image has a
+// random shift added to all its pixels, clamped to [0,255]. The shift for
+// each image is sampled uniformly from [-delta*255, delta*255].
+func (s *Set) RandomBrightness(delta float64, r *rand.Rand) Set {
+	return s.mapImages(func(dst, src []byte) {
+		shift := (2*r.Float64() - 1) * delta * 255
+		for j, v := range src {
+			dst[j] = clampByte(float64(v) + shift)
+		}
+	})
+}
+
+// RandomContrast returns a copy of the data set where the contrast of each
+// image is scaled by a random factor m sampled uniformly from [lower, upper).
+// Each pixel px of an image is transformed to (px-mean)*m+mean, where mean is
+// the mean pixel value of the image, and clamped to [0,255].
+func (s *Set) RandomContrast(lower, upper float64, r *rand.Rand) Set {
+	return s.mapImages(func(dst, src []byte) {
+		m := lower + (upper-lower)*r.Float64()
+		var sum float64
+		for _, v := range src {
+			sum += float64(v)
+		}
+		mean := sum / float64(len(src))
+		for j, v := range src {
+			dst[j] = clampByte((float64(v)-mean)*m + mean)
+		}
+	})
+}
+
+// mapImages returns a copy of the data set where each image is the result of
+// calling fn with a destination image and the corresponding source image.
+// The labels of the returned Set are shared with s.
+func (s *Set) mapImages(fn func(dst, src []byte)) Set {
+	c := *s
+	c.matrix = make([]byte, len(s.matrix))
+	stride := s.Rows() * s.Cols()
+	for i := 0; i < s.Len(); i++ {
+		_, img := s.Index(i)
+		fn(c.matrix[i*stride:(i+1)*stride:(i+1)*stride], img)
+	}
+	return c
+}
+
+// clampByte returns v rounded to the nearest integer and clamped to [0,255].
+func clampByte(v float64) byte {
+	return byte(math.Max(0, math.Min(255, math.Round(v))))
+}
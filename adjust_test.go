@@ -0,0 +1,100 @@
+// Copyright ©2026 The bíogo.nn Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mnist
+
+import (
+	"bytes"
+	"math"
+	"math/rand"
+	"testing"
+)
+
+func TestRandomBrightness(t *testing.T) {
+	s := subset(Test, 200)
+	orig := s.Images()
+
+	got := s.RandomBrightness(0, rand.New(rand.NewSource(1)))
+	if !got.Equal(s) {
+		t.Error("Zero delta altered images")
+	}
+
+	got = s.RandomBrightness(0.5, rand.New(rand.NewSource(1)))
+	if !bytes.Equal(s.matrix, orig) {
+		t.Error("RandomBrightness altered source data")
+	}
+	if !bytes.Equal(got.labels, s.labels) {
+		t.Error("RandomBrightness altered labels")
+	}
+	var changed int
+	for i := 0; i < s.Len(); i++ {
+		_, src := s.Index(i)
+		_, dst := got.Index(i)
+		if !bytes.Equal(src, dst) {
+			changed++
+		}
+		// Pixels are bytes so cannot leave [0,255]; check that
+		// the shift saturates rather than wrapping, which would
+		// move some pixels in the opposite direction.
+		var up, down bool
+		for j, v := range src {
+			switch {
+			case dst[j] > v:
+				up = true
+			case dst[j] < v:
+				down = true
+			}
+		}
+		if up && down {
+			t.Errorf("Inconsistent brightness shift in image %d: pixels wrapped", i)
+		}
+	}
+	if changed == 0 {
+		t.Error("No images altered by non-zero delta")
+	}
+}
+
+func TestRandomContrast(t *testing.T) {
+	s := subset(Test, 200)
+	got := s.RandomContrast(1, 1, rand.New(rand.NewSource(1)))
+	if !got.Equal(s) {
+		t.Error("Unit contrast altered images")
+	}
+
+	// Use mid-grey images so that increased
+	// contrast is not limited by clamping.
+	rnd := rand.New(rand.NewSource(1))
+	images := make([][]byte, 20)
+	labels := make([]byte, len(images))
+	for i := range images {
+		images[i] = make([]byte, 28*28)
+		for j := range images[i] {
+			images[i][j] = byte(100 + rnd.Intn(56))
+		}
+	}
+	grey, err := NewSet(images, labels, 28, 28)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	got = grey.RandomContrast(1.5, 2, rand.New(rand.NewSource(1)))
+	for i := 0; i < grey.Len(); i++ {
+		_, src := grey.Index(i)
+		_, dst := got.Index(i)
+		before, after := stdDev(src), stdDev(dst)
+		if after <= before {
+			t.Errorf("Pixel spread not increased for image %d: got: %v want: >%v", i, after, before)
+		}
+	}
+}
+
+func stdDev(img []byte) float64 {
+	var sum, sumSq float64
+	for _, v := range img {
+		sum += float64(v)
+		sumSq += float64(v) * float64(v)
+	}
+	n := float64(len(img))
+	mean := sum / n
+	return math.Sqrt(sumSq/n - mean*mean)
+}
@@ -0,0 +1,83 @@
+// Copyright ©2026 The bíogo.nn Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mnist
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+)
+
+// asciiRamp is the sequence of characters used to render pixel
+// values from background to foreground.
+const asciiRamp = " .:-=+*#%@"
+
+// asciiShade returns the character used to render the pixel value v.
+func asciiShade(v byte) byte {
+	return asciiRamp[int(v)*len(asciiRamp)/256]
+}
+
+// VisualizeASCII writes an ASCII art rendering of the i'th image of the data
+// set to w, with one character per pixel and a newline after each row. Pixel
+// values are rendered with the ten shades " .:-=+*#%@", from background to
+// foreground.
+func (s *Set) VisualizeASCII(i int, w io.Writer) error {
+	rows, cols := s.Dimensions()
+	_, img := s.Index(i)
+	bw := bufio.NewWriter(w)
+	line := make([]byte, cols+1)
+	line[cols] = '\n'
+	for y := 0; y < rows; y++ {
+		for x, v := range img[y*cols : (y+1)*cols] {
+			line[x] = asciiShade(v)
+		}
+		bw.Write(line)
+	}
+	return bw.Flush()
+}
+
+// VisualizeASCIIGrid writes ASCII art renderings of the images of the data set
+// at the given indices to w, arranged in a grid with cols images per row. As for
+// VisualizeASCII, each image uses one character per pixel. Images in a grid row
+// are separated by a single space column and grid rows are separated by a line
+// of spaces, so every output line has cols*(s.Cols()+1)-1 characters followed by
+// a newline. A final partial grid row is padded with spaces.
+func (s *Set) VisualizeASCIIGrid(w io.Writer, indices []int, cols int) error {
+	if cols < 1 {
+		return errors.New("mnist: grid must have at least one column")
+	}
+	for _, i := range indices {
+		if i < 0 || i >= s.Len() {
+			return fmt.Errorf("mnist: index out of range: %d", i)
+		}
+	}
+	rows, width := s.Dimensions()
+	bw := bufio.NewWriter(w)
+	line := make([]byte, cols*(width+1))
+	line[len(line)-1] = '\n'
+	for start := 0; start < len(indices); start += cols {
+		if start != 0 {
+			for k := range line[:len(line)-1] {
+				line[k] = ' '
+			}
+			bw.Write(line)
+		}
+		row := indices[start:min(start+cols, len(indices))]
+		for y := 0; y < rows; y++ {
+			for k := range line[:len(line)-1] {
+				line[k] = ' '
+			}
+			for c, i := range row {
+				_, img := s.Index(i)
+				for x, v := range img[y*width : (y+1)*width] {
+					line[c*(width+1)+x] = asciiShade(v)
+				}
+			}
+			bw.Write(line)
+		}
+	}
+	return bw.Flush()
+}
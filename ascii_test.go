@@ -0,0 +1,101 @@
+// Copyright ©2026 The bíogo.nn Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mnist
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+)
+
+func TestVisualizeASCII(t *testing.T) {
+	for _, test := range []struct {
+		name  string
+		value byte
+		want  string
+	}{
+		{name: "blank", value: 0, want: strings.Repeat(" ", 28)},
+		{name: "full", value: 255, want: strings.Repeat("@", 28)},
+		{name: "mid", value: 128, want: strings.Repeat("+", 28)},
+	} {
+		s := Set{count: 1, rows: 28, cols: 28, matrix: bytes.Repeat([]byte{test.value}, 28*28), labels: []byte{0}}
+		var buf bytes.Buffer
+		err := s.VisualizeASCII(0, &buf)
+		if err != nil {
+			t.Fatalf("Unexpected error for %s image: %v", test.name, err)
+		}
+		want := strings.Repeat(test.want+"\n", 28)
+		if got := buf.String(); got != want {
+			t.Errorf("Unexpected rendering of %s image:\ngot:\n%q\nwant:\n%q", test.name, got, want)
+		}
+	}
+
+	s := Set{count: 1, rows: 1, cols: 10, matrix: []byte{0, 26, 52, 77, 103, 128, 154, 180, 205, 231}, labels: []byte{0}}
+	var buf bytes.Buffer
+	err := s.VisualizeASCII(0, &buf)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if got, want := buf.String(), asciiRamp+"\n"; got != want {
+		t.Errorf("Unexpected rendering of ramp: got: %q want: %q", got, want)
+	}
+}
+
+func TestVisualizeASCIIGrid(t *testing.T) {
+	for _, test := range []struct {
+		indices []int
+		cols    int
+	}{
+		{indices: []int{0}, cols: 1},
+		{indices: []int{0, 1, 2, 3}, cols: 2},
+		{indices: []int{0, 1, 2, 3, 4}, cols: 3},
+		{indices: []int{5, 4, 3, 2, 1, 0, 9, 8}, cols: 8},
+	} {
+		var buf bytes.Buffer
+		err := Test.VisualizeASCIIGrid(&buf, test.indices, test.cols)
+		if err != nil {
+			t.Fatalf("Unexpected error for %v: %v", test.indices, err)
+		}
+		gridRows := (len(test.indices) + test.cols - 1) / test.cols
+		lines := strings.SplitAfter(buf.String(), "\n")
+		lines = lines[:len(lines)-1] // Remove empty string after final newline.
+		if want := gridRows*(Test.Rows()+1) - 1; len(lines) != want {
+			t.Errorf("Unexpected number of lines for %v: got: %d want: %d", test.indices, len(lines), want)
+		}
+		width := test.cols*(Test.Cols()+1) - 1
+		for k, l := range lines {
+			if len(l) != width+1 || l[width] != '\n' {
+				t.Fatalf("Unexpected length of line %d for %v: got: %d want: %d", k, test.indices, len(l), width+1)
+			}
+		}
+
+		// Each cell must match the single image rendering.
+		for n, i := range test.indices {
+			var single bytes.Buffer
+			err := Test.VisualizeASCII(i, &single)
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			want := strings.Split(single.String(), "\n")
+			r, c := n/test.cols, n%test.cols
+			for y := 0; y < Test.Rows(); y++ {
+				l := lines[r*(Test.Rows()+1)+y]
+				got := l[c*(Test.Cols()+1) : c*(Test.Cols()+1)+Test.Cols()]
+				if got != want[y] {
+					t.Fatalf("Unexpected row %d of image %d: got: %q want: %q", y, i, got, want[y])
+				}
+			}
+		}
+	}
+
+	err := Test.VisualizeASCIIGrid(&bytes.Buffer{}, []int{0, Test.Len()}, 2)
+	if err == nil {
+		t.Error("Expected error for out of range index")
+	}
+	err = Test.VisualizeASCIIGrid(&bytes.Buffer{}, []int{0}, 0)
+	if err == nil {
+		t.Error("Expected error for zero columns")
+	}
+}
@@ -0,0 +1,48 @@
+// Copyright ©2026 The bíogo.nn Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mnist
+
+import "math/rand"
+
+// IterBatches returns a function that iterates over the data set in batches of
+// batchSize samples. Each call returns the labels and images of the next batch
+// and ok=true, with the last batch of an epoch holding the remaining samples if
+// s.Len() is not a multiple of batchSize. When the epoch is exhausted the
+// function returns ok=false and resets to the start of the next epoch.
+//
+// If shuffle is true, the order of samples is permuted using r at the start of
+// each epoch, and r must not be nil. The image slices are those returned by
+// Index, and the returned slices are reused by subsequent calls.
+// IterBatches panics if batchSize is less than one.
+func (s *Set) IterBatches(batchSize int, shuffle bool, r *rand.Rand) func() (labels []byte, images [][]byte, ok bool) {
+	if batchSize < 1 {
+		panic("mnist: batch size must be positive")
+	}
+	order := make([]int, s.Len())
+	for i := range order {
+		order[i] = i
+	}
+	labels := make([]byte, 0, batchSize)
+	images := make([][]byte, 0, batchSize)
+	var next int
+	return func() ([]byte, [][]byte, bool) {
+		if next == 0 && shuffle {
+			r.Shuffle(len(order), func(i, j int) { order[i], order[j] = order[j], order[i] })
+		}
+		if next >= len(order) {
+			next = 0
+			return nil, nil, false
+		}
+		end := min(next+batchSize, len(order))
+		labels, images = labels[:0], images[:0]
+		for _, i := range order[next:end] {
+			l, img := s.Index(i)
+			labels = append(labels, l)
+			images = append(images, img)
+		}
+		next = end
+		return labels, images, true
+	}
+}
@@ -0,0 +1,72 @@
+// Copyright ©2026 The bíogo.nn Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mnist
+
+import (
+	"bytes"
+	"math/rand"
+	"testing"
+)
+
+func TestIterBatches(t *testing.T) {
+	s := subset(Test, 1000)
+	for _, test := range []struct {
+		batchSize int
+		shuffle   bool
+	}{
+		{batchSize: 1},
+		{batchSize: 100},
+		{batchSize: 128},
+		{batchSize: 128, shuffle: true},
+		{batchSize: 5000, shuffle: true},
+	} {
+		next := s.IterBatches(test.batchSize, test.shuffle, rand.New(rand.NewSource(1)))
+		var firsts [][]byte
+		for epoch := 0; epoch < 2; epoch++ {
+			var n int
+			seen := make(map[string]int)
+			for {
+				labels, images, ok := next()
+				if !ok {
+					break
+				}
+				if len(labels) != len(images) {
+					t.Fatalf("Mismatched batch lengths for %+v: %d labels %d images", test, len(labels), len(images))
+				}
+				if len(labels) > test.batchSize {
+					t.Fatalf("Unexpected batch size for %+v: got: %d want: ≤%d", test, len(labels), test.batchSize)
+				}
+				if len(labels) < test.batchSize && n+len(labels) != s.Len() {
+					t.Fatalf("Short batch before end of epoch for %+v", test)
+				}
+				if n == 0 {
+					firsts = append(firsts, append([]byte(nil), images[0]...))
+				}
+				for k, img := range images {
+					seen[string(img)]++
+					if !test.shuffle {
+						l, want := s.Index(n + k)
+						if labels[k] != l || !bytes.Equal(img, want) {
+							t.Fatalf("Unexpected sample %d for %+v", n+k, test)
+						}
+					}
+				}
+				n += len(labels)
+			}
+			if n != s.Len() {
+				t.Errorf("Unexpected number of samples in epoch %d for %+v: got: %d want: %d", epoch, test, n, s.Len())
+			}
+			for i := 0; i < s.Len(); i++ {
+				_, img := s.Index(i)
+				if seen[string(img)] == 0 {
+					t.Fatalf("Sample %d missing from epoch %d for %+v", i, epoch, test)
+				}
+			}
+		}
+		if test.shuffle && bytes.Equal(firsts[0], firsts[1]) {
+			t.Errorf("Unexpected identical first samples between shuffled epochs for %+v", test)
+		}
+	}
+}
@@ -0,0 +1,75 @@
+// Copyright ©2026 The bíogo.nn Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mnist
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+)
+
+// binaryVersion is the version of the format written by MarshalBinary.
+const binaryVersion = 1
+
+// binaryHeaderLen is the length of the header written by MarshalBinary:
+// a version byte followed by the image and label magic numbers and the
+// count, rows and cols of the set.
+const binaryHeaderLen = 1 + 5*4
+
+// MarshalBinary implements the encoding.BinaryMarshaler interface. The
+// encoding is a version byte, the IDX image and label magic numbers and
+// the number of images, rows and columns as big-endian int32 values,
+// followed by the raw pixel bytes and then the raw label bytes. Metadata
+// attached to the set is not included.
+func (s *Set) MarshalBinary() ([]byte, error) {
+	b := make([]byte, binaryHeaderLen, binaryHeaderLen+len(s.matrix)+len(s.labels))
+	b[0] = binaryVersion
+	for i, v := range []int32{xIMG, xLAB, s.count, s.rows, s.cols} {
+		binary.BigEndian.PutUint32(b[1+4*i:], uint32(v))
+	}
+	b = append(b, s.matrix...)
+	b = append(b, s.labels...)
+	return b, nil
+}
+
+// UnmarshalBinary implements the encoding.BinaryUnmarshaler interface,
+// decoding data written by MarshalBinary. The state of s is completely
+// replaced by the decoded set, and s does not retain data.
+func (s *Set) UnmarshalBinary(data []byte) error {
+	if len(data) < binaryHeaderLen {
+		return errors.New("mnist: binary data too short")
+	}
+	if data[0] != binaryVersion {
+		return fmt.Errorf("mnist: unsupported binary version: %d", data[0])
+	}
+	var hdr [5]int32
+	for i := range hdr {
+		hdr[i] = int32(binary.BigEndian.Uint32(data[1+4*i:]))
+	}
+	if hdr[0] != xIMG {
+		return fmt.Errorf("mnist: invalid magic number for images: %x", hdr[0])
+	}
+	if hdr[1] != xLAB {
+		return fmt.Errorf("mnist: invalid magic number for labels: %x", hdr[1])
+	}
+	count, rows, cols := hdr[2], hdr[3], hdr[4]
+	if count < 0 || rows < 0 || cols < 0 {
+		return errors.New("mnist: invalid dimensions")
+	}
+	n := int64(count) * int64(rows) * int64(cols)
+	data = data[binaryHeaderLen:]
+	if int64(len(data)) != n+int64(count) {
+		return errors.New("mnist: mismatched binary data length")
+	}
+	*s = Set{
+		count:  count,
+		rows:   rows,
+		cols:   cols,
+		matrix: bytes.Clone(data[:n]),
+		labels: bytes.Clone(data[n:]),
+	}
+	return nil
+}
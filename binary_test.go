@@ -0,0 +1,80 @@
+// Copyright ©2026 The bíogo.nn Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mnist
+
+import (
+	"encoding"
+	"reflect"
+	"testing"
+)
+
+var (
+	_ encoding.BinaryMarshaler   = (*Set)(nil)
+	_ encoding.BinaryUnmarshaler = (*Set)(nil)
+)
+
+func TestBinaryRoundTrip(t *testing.T) {
+	for _, s := range []struct {
+		name string
+		set  Set
+	}{
+		{name: "Train", set: Train},
+		{name: "Test", set: Test},
+		{name: "patches", set: func() Set { p, _ := Test.Patches(7); return p }()},
+		{name: "empty", set: Set{rows: 28, cols: 28, matrix: []byte{}, labels: []byte{}}},
+	} {
+		want := s.set.WithMetadata(Metadata{})
+		b, err := want.MarshalBinary()
+		if err != nil {
+			t.Fatalf("Unexpected error marshaling %q: %v", s.name, err)
+		}
+
+		// Start from a set with existing state to check it is replaced.
+		got := subset(Test, 10)
+		got.meta = Metadata{Source: "stale"}
+		err = got.UnmarshalBinary(b)
+		if err != nil {
+			t.Fatalf("Unexpected error unmarshaling %q: %v", s.name, err)
+		}
+		if !reflect.DeepEqual(got, want) {
+			t.Errorf("Unexpected round trip for %q", s.name)
+		}
+		if len(b) > binaryHeaderLen && len(got.matrix) != 0 {
+			b[binaryHeaderLen]++
+			if got.matrix[0] == b[binaryHeaderLen] {
+				t.Errorf("Unmarshaled set for %q retains data", s.name)
+			}
+		}
+	}
+}
+
+func TestUnmarshalBinaryErrors(t *testing.T) {
+	s := subset(Test, 10)
+	valid, err := s.MarshalBinary()
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	corrupt := func(fn func(b []byte) []byte) []byte {
+		return fn(append([]byte(nil), valid...))
+	}
+	for _, test := range []struct {
+		name string
+		data []byte
+	}{
+		{name: "empty", data: nil},
+		{name: "short header", data: valid[:binaryHeaderLen-1]},
+		{name: "version", data: corrupt(func(b []byte) []byte { b[0] = 2; return b })},
+		{name: "image magic", data: corrupt(func(b []byte) []byte { b[4] = 0; return b })},
+		{name: "label magic", data: corrupt(func(b []byte) []byte { b[8] = 0; return b })},
+		{name: "truncated", data: valid[:len(valid)-1]},
+		{name: "trailing", data: append(append([]byte(nil), valid...), 0)},
+	} {
+		var got Set
+		err := got.UnmarshalBinary(test.data)
+		if err == nil {
+			t.Errorf("Expected error for %s data", test.name)
+		}
+	}
+}
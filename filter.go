@@ -0,0 +1,121 @@
+// Copyright ©2026 The bíogo.nn Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mnist
+
+import "math"
+
+// sharpenSigma is the standard deviation of the Gaussian
+// blur used to construct the unsharp mask in Sharpen.
+const sharpenSigma = 1
+
+// Sharpen returns a copy of the data set where each image has been sharpened
+// with an unsharp mask. Each pixel is transformed to
+//
+//	original + strength*(original - blurred)
+//
+// clamped to [0,255], where blurred is the image convolved with a Gaussian
+// kernel with a standard deviation of one pixel. Pixels beyond the edge of
+// the image take the value of the nearest edge pixel.
+func (s *Set) Sharpen(strength float64) Set {
+	rows, cols := s.Dimensions()
+	kernel := gaussianKernel(sharpenSigma)
+	blurred := make([]float64, rows*cols)
+	work := make([]float64, rows*cols)
+	return s.mapImages(func(dst, src []byte) {
+		gaussianBlur(blurred, work, src, rows, cols, kernel)
+		for j, v := range src {
+			dst[j] = clampByte(float64(v) + strength*(float64(v)-blurred[j]))
+		}
+	})
+}
+
+// AdaptiveThreshold returns a copy of the data set where each pixel is set
+// to 255 if its value is greater than the mean of the blockSize×blockSize
+// neighbourhood centred on it minus C, and to 0 otherwise. Neighbourhoods
+// are truncated at the edges of the image. AdaptiveThreshold panics if
+// blockSize is less than one.
+func (s *Set) AdaptiveThreshold(blockSize int, C byte) Set {
+	if blockSize < 1 {
+		panic("mnist: block size must be positive")
+	}
+	rows, cols := s.Dimensions()
+	half := blockSize / 2
+	sums := make([]int, (rows+1)*(cols+1))
+	return s.mapImages(func(dst, src []byte) {
+		integralImage(sums, src, rows, cols)
+		for y := 0; y < rows; y++ {
+			y0, y1 := max(y-half, 0), min(y-half+blockSize, rows)
+			for x := 0; x < cols; x++ {
+				x0, x1 := max(x-half, 0), min(x-half+blockSize, cols)
+				sum := sums[y1*(cols+1)+x1] - sums[y0*(cols+1)+x1] - sums[y1*(cols+1)+x0] + sums[y0*(cols+1)+x0]
+				mean := float64(sum) / float64((y1-y0)*(x1-x0))
+				if float64(src[y*cols+x]) > mean-float64(C) {
+					dst[y*cols+x] = 255
+				} else {
+					dst[y*cols+x] = 0
+				}
+			}
+		}
+	})
+}
+
+// integralImage fills sums with the summed-area table of the rows×cols
+// image in src. Element [y*(cols+1)+x] of sums holds the sum of the
+// pixels above and to the left of (y, x). sums must have length
+// (rows+1)*(cols+1).
+func integralImage(sums []int, src []byte, rows, cols int) {
+	for y := 0; y < rows; y++ {
+		var row int
+		for x := 0; x < cols; x++ {
+			row += int(src[y*cols+x])
+			sums[(y+1)*(cols+1)+x+1] = sums[y*(cols+1)+x+1] + row
+		}
+	}
+}
+
+// gaussianKernel returns a normalised one-dimensional Gaussian kernel
+// with the given standard deviation, truncated at three standard
+// deviations. The centre of the kernel is at len(kernel)/2.
+func gaussianKernel(sigma float64) []float64 {
+	radius := int(math.Ceil(3 * sigma))
+	kernel := make([]float64, 2*radius+1)
+	var sum float64
+	for i := range kernel {
+		x := float64(i - radius)
+		kernel[i] = math.Exp(-x * x / (2 * sigma * sigma))
+		sum += kernel[i]
+	}
+	for i := range kernel {
+		kernel[i] /= sum
+	}
+	return kernel
+}
+
+// gaussianBlur convolves the rows×cols image in src with the separable
+// kernel, writing the result to dst. work is used as scratch space and
+// must have the same length as dst.
+func gaussianBlur(dst, work []float64, src []byte, rows, cols int, kernel []float64) {
+	radius := len(kernel) / 2
+	for y := 0; y < rows; y++ {
+		for x := 0; x < cols; x++ {
+			var v float64
+			for k, w := range kernel {
+				xx := min(max(x+k-radius, 0), cols-1)
+				v += w * float64(src[y*cols+xx])
+			}
+			work[y*cols+x] = v
+		}
+	}
+	for y := 0; y < rows; y++ {
+		for x := 0; x < cols; x++ {
+			var v float64
+			for k, w := range kernel {
+				yy := min(max(y+k-radius, 0), rows-1)
+				v += w * work[yy*cols+x]
+			}
+			dst[y*cols+x] = v
+		}
+	}
+}
@@ -0,0 +1,118 @@
+// Copyright ©2026 The bíogo.nn Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mnist
+
+import (
+	"bytes"
+	"testing"
+)
+
+func TestSharpen(t *testing.T) {
+	s := subset(Test, 100)
+	got := s.Sharpen(0)
+	if !got.Equal(s) {
+		t.Error("Zero strength altered images")
+	}
+
+	// A mid-grey square on a black background, so that
+	// sharpening is not limited by clamping.
+	sq := drawSet(
+		"..........",
+		"..........",
+		"..######..",
+		"..######..",
+		"..######..",
+		"..######..",
+		"..######..",
+		"..######..",
+		"..........",
+		"..........",
+	)
+	for i, v := range sq.matrix {
+		sq.matrix[i] = v / 2
+	}
+	orig := sq.Images()
+	got = sq.Sharpen(1)
+	if !bytes.Equal(sq.matrix, orig) {
+		t.Error("Sharpen altered source data")
+	}
+	for _, p := range []struct{ r, c int }{{2, 2}, {2, 4}, {4, 2}, {7, 7}, {5, 7}} {
+		before, after := sq.At(0, p.r, p.c), got.At(0, p.r, p.c)
+		if after <= before {
+			t.Errorf("Edge pixel at (%d,%d) not enhanced: got: %d want: >%d", p.r, p.c, after, before)
+		}
+	}
+	for _, p := range []struct{ r, c int }{{1, 2}, {4, 1}, {8, 8}} {
+		if v := got.At(0, p.r, p.c); v != 0 {
+			t.Errorf("Background pixel at (%d,%d) not darkened: got: %d want: 0", p.r, p.c, v)
+		}
+	}
+}
+
+func TestAdaptiveThreshold(t *testing.T) {
+	const rows, cols = 12, 12
+	for _, v := range []byte{0, 1, 64, 200, 255} {
+		for _, c := range []byte{0, 1, 64, 255} {
+			img := bytes.Repeat([]byte{v}, rows*cols)
+			s, err := NewSet([][]byte{img}, []byte{0}, rows, cols)
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			got := s.AdaptiveThreshold(3, c)
+			// The neighbourhood mean of a constant image is v,
+			// so v > v-C exactly when C is positive.
+			want := byte(0)
+			if c > 0 {
+				want = 255
+			}
+			for j, p := range got.matrix {
+				if p != want {
+					t.Errorf("Unexpected value for constant %d with C=%d at %d: got: %d want: %d", v, c, j, p, want)
+					break
+				}
+			}
+		}
+	}
+
+	// A vertical stroke on a background with a brightness step.
+	img := make([]byte, rows*cols)
+	for y := 0; y < rows; y++ {
+		for x := 0; x < cols; x++ {
+			bg := byte(50)
+			if x >= cols/2 {
+				bg = 150
+			}
+			img[y*cols+x] = bg
+			if x == 2 || x == cols-3 {
+				img[y*cols+x] = bg + 60
+			}
+		}
+	}
+	s, err := NewSet([][]byte{img}, []byte{0}, rows, cols)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	got := s.AdaptiveThreshold(3, 0)
+	for y := 0; y < rows; y++ {
+		for _, x := range []int{2, cols - 3} {
+			if v := got.At(0, y, x); v != 255 {
+				t.Errorf("Unexpected value for stroke at (%d,%d): got: %d want: 255", y, x, v)
+			}
+		}
+		for _, x := range []int{0, 4, cols - 5, cols - 1} {
+			if v := got.At(0, y, x); v != 0 {
+				t.Errorf("Unexpected value for background at (%d,%d): got: %d want: 0", y, x, v)
+			}
+		}
+	}
+
+	digits := subset(Test, 100)
+	for j, v := range digits.AdaptiveThreshold(5, 2).matrix {
+		if v != 0 && v != 255 {
+			t.Errorf("Unexpected non-binary value at %d: %d", j, v)
+			break
+		}
+	}
+}
@@ -0,0 +1,193 @@
+// Copyright ©2026 The bíogo.nn Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mnist
+
+import (
+	"fmt"
+	"math"
+)
+
+// A FloatSet contains a set of labelled real-valued feature vectors
+// derived from a Set. Vectors may be interpreted as images with shape
+// (channels, rows, cols) in channel-first order. Feature vectors that
+// are not images have the shape (1, 1, dim).
+type FloatSet struct {
+	count  int
+	dim    int
+	data   []float32 // count*dim
+	labels []byte    // count
+
+	channels, rows, cols int // channels*rows*cols == dim
+}
+
+// newFloatSet returns a FloatSet able to hold n vectors of length dim
+// with the labels held in labels.
+func newFloatSet(n, dim int, labels []byte) FloatSet {
+	return FloatSet{
+		count:    n,
+		dim:      dim,
+		data:     make([]float32, n*dim),
+		labels:   append([]byte(nil), labels...),
+		channels: 1,
+		rows:     1,
+		cols:     dim,
+	}
+}
+
+// Len returns the number of labelled vectors in the data set.
+func (s *FloatSet) Len() int { return s.count }
+
+// Dim returns the length of the vectors in the data set.
+func (s *FloatSet) Dim() int { return s.dim }
+
+// Channels returns the number of channels in the vectors of the data set.
+func (s *FloatSet) Channels() int { return s.channels }
+
+// Rows returns the number of rows in each channel of the vectors of the data set.
+func (s *FloatSet) Rows() int { return s.rows }
+
+// Cols returns the number of columns in each channel of the vectors of the data set.
+func (s *FloatSet) Cols() int { return s.cols }
+
+// Index returns the i'th label and vector of the data set.
+func (s *FloatSet) Index(i int) (label byte, vec []float32) {
+	return s.labels[i], s.data[i*s.dim : (i+1)*s.dim]
+}
+
+// ImageFloat32 returns the i'th vector of the data set. For image data sets the
+// element at channel ch, row r and column c is at index (ch*rows+r)*cols+c.
+func (s *FloatSet) ImageFloat32(i int) []float32 {
+	return s.data[i*s.dim : (i+1)*s.dim]
+}
+
+// ChannelFirst returns a FloatSet holding the images of the data set in
+// channel-first (1, rows, cols) layout with pixel values scaled to [0,1].
+func (s *Set) ChannelFirst() FloatSet {
+	rows, cols := s.Dimensions()
+	fs := newFloatSet(s.Len(), rows*cols, s.labels)
+	fs.rows, fs.cols = rows, cols
+	for i := 0; i < s.Len(); i++ {
+		// MNIST images have a single channel, so the
+		// channel-first layout matches the pixel layout.
+		s.ScanFloat32(i, fs.ImageFloat32(i))
+	}
+	return fs
+}
+
+// ImageFloat32 returns a newly allocated copy of the i'th image of the data set
+// with pixel values scaled to [0,1].
+func (s *Set) ImageFloat32(i int) []float32 {
+	dst := make([]float32, s.Rows()*s.Cols())
+	s.ScanFloat32(i, dst)
+	return dst
+}
+
+// A Float32Set is a view of a Set that provides images with pixel values
+// scaled to [0,1] without holding a converted copy of the data set.
+// Images are converted when they are accessed.
+type Float32Set struct {
+	set *Set
+}
+
+// AsFloat32Set returns a Float32Set view of the data set. The view shares the
+// pixel and label data of s and performs no conversion until images are
+// accessed.
+func (s *Set) AsFloat32Set() Float32Set {
+	return Float32Set{set: s}
+}
+
+// Len returns the number of labelled images in the data set.
+func (s *Float32Set) Len() int { return s.set.Len() }
+
+// Label returns the label of the i'th image of the data set.
+func (s *Float32Set) Label(i int) byte { return s.set.labels[i] }
+
+// ImageFloat32 returns a newly allocated copy of the i'th image of the data set
+// with pixel values scaled to [0,1]. Use ScanFloat32 to convert into a
+// caller-owned buffer without allocating.
+func (s *Float32Set) ImageFloat32(i int) []float32 {
+	return s.set.ImageFloat32(i)
+}
+
+// ScanFloat32 copies the i'th image of the data set into dst with pixel values
+// scaled to [0,1]. ScanFloat32 returns an error if dst is shorter than the
+// number of pixels in an image.
+func (s *Float32Set) ScanFloat32(i int, dst []float32) error {
+	return s.set.ScanFloat32(i, dst)
+}
+
+// ProjectFeatures returns a FloatSet holding the pixels at the given indices of
+// each image of the data set, in the order given, with values scaled to [0,1].
+func (s *Set) ProjectFeatures(pixelIndices []int) FloatSet {
+	fs := newFloatSet(s.Len(), len(pixelIndices), s.labels)
+	for i := 0; i < s.Len(); i++ {
+		_, img := s.Index(i)
+		_, vec := fs.Index(i)
+		for j, p := range pixelIndices {
+			vec[j] = float32(img[p]) / 255
+		}
+	}
+	return fs
+}
+
+// Embed returns a FloatSet holding the product of projectionMatrix and each image
+// of the data set, with pixel values scaled to [0,1]. The projection matrix must
+// have rows*cols columns, and each row defines one element of the output vectors.
+// With a random Gaussian projection matrix this is a Johnson-Lindenstrauss random
+// projection, which approximately preserves distances between images.
+func (s *Set) Embed(projectionMatrix [][]float64) (FloatSet, error) {
+	dim := s.Rows() * s.Cols()
+	for i, row := range projectionMatrix {
+		if len(row) != dim {
+			return FloatSet{}, fmt.Errorf("mnist: projection matrix row %d has length %d, want %d", i, len(row), dim)
+		}
+	}
+	fs := newFloatSet(s.Len(), len(projectionMatrix), s.labels)
+	x := make([]float64, dim)
+	for i := 0; i < s.Len(); i++ {
+		_, img := s.Index(i)
+		for j, v := range img {
+			x[j] = float64(v) / 255
+		}
+		_, vec := fs.Index(i)
+		for k, row := range projectionMatrix {
+			var sum float64
+			for j, w := range row {
+				sum += w * x[j]
+			}
+			vec[k] = float32(sum)
+		}
+	}
+	return fs, nil
+}
+
+// instanceEpsilon is added to the standard deviation of each image in
+// InstanceNormalize to avoid division by zero for constant images.
+const instanceEpsilon = 1e-8
+
+// InstanceNormalize returns a FloatSet holding each image of the data set
+// normalised by its own mean and standard deviation, so that each vector
+// has zero mean and unit variance. Constant images are mapped to zero
+// vectors. The mean and variance of each image are computed in a single
+// pass using Welford's algorithm.
+func (s *Set) InstanceNormalize() FloatSet {
+	fs := newFloatSet(s.Len(), s.Rows()*s.Cols(), s.labels)
+	for i := 0; i < s.Len(); i++ {
+		_, img := s.Index(i)
+		var mean, m2 float64
+		for j, v := range img {
+			x := float64(v)
+			d := x - mean
+			mean += d / float64(j+1)
+			m2 += d * (x - mean)
+		}
+		std := math.Sqrt(m2/float64(len(img))) + instanceEpsilon
+		_, vec := fs.Index(i)
+		for j, v := range img {
+			vec[j] = float32((float64(v) - mean) / std)
+		}
+	}
+	return fs
+}
@@ -0,0 +1,219 @@
+// Copyright ©2026 The bíogo.nn Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mnist
+
+import (
+	"math"
+	"math/rand"
+	"testing"
+)
+
+func TestProjectFeatures(t *testing.T) {
+	for _, indices := range [][]int{
+		{0, 1, 2},
+		{406, 0, 783, 406},
+	} {
+		fs := Test.ProjectFeatures(indices)
+		if fs.Len() != Test.Len() || fs.Dim() != len(indices) {
+			t.Fatalf("Unexpected shape for %v: got: %d×%d want: %d×%d", indices, fs.Len(), fs.Dim(), Test.Len(), len(indices))
+		}
+		for i := 0; i < Test.Len(); i++ {
+			label, vec := fs.Index(i)
+			wantLabel, img := Test.Index(i)
+			if label != wantLabel {
+				t.Fatalf("Unexpected label at %d for %v: got: %d want: %d", i, indices, label, wantLabel)
+			}
+			for j, p := range indices {
+				if want := float32(img[p]) / 255; vec[j] != want {
+					t.Fatalf("Unexpected value at %d[%d] for %v: got: %v want: %v", i, j, indices, vec[j], want)
+				}
+			}
+		}
+	}
+}
+
+func TestEmbed(t *testing.T) {
+	const k = 256
+	dim := Test.Rows() * Test.Cols()
+	r := rand.New(rand.NewSource(1))
+	proj := make([][]float64, k)
+	for i := range proj {
+		proj[i] = make([]float64, dim)
+		for j := range proj[i] {
+			proj[i][j] = r.NormFloat64() / math.Sqrt(k)
+		}
+	}
+
+	src := subset(Test, 100)
+	fs, err := src.Embed(proj)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if fs.Len() != src.Len() || fs.Dim() != k {
+		t.Fatalf("Unexpected shape: got: %d×%d want: %d×%d", fs.Len(), fs.Dim(), src.Len(), k)
+	}
+
+	// Distances are preserved within a factor of 1±eps for the
+	// great majority of pairs.
+	const eps = 0.3
+	var pairs, bad int
+	for i := 0; i < src.Len(); i++ {
+		for j := i + 1; j < src.Len(); j++ {
+			_, a := src.Index(i)
+			_, b := src.Index(j)
+			want := math.Sqrt(float64(sqDist(a, b))) / 255
+			if want == 0 {
+				continue
+			}
+			_, u := fs.Index(i)
+			_, v := fs.Index(j)
+			var got float64
+			for n := range u {
+				d := float64(u[n] - v[n])
+				got += d * d
+			}
+			got = math.Sqrt(got)
+			pairs++
+			if ratio := got / want; ratio < 1-eps || ratio > 1+eps {
+				bad++
+			}
+		}
+	}
+	if frac := float64(bad) / float64(pairs); frac > 0.01 {
+		t.Errorf("Too many distorted distances: %d of %d pairs", bad, pairs)
+	}
+
+	_, err = src.Embed([][]float64{make([]float64, dim-1)})
+	if err == nil {
+		t.Error("Expected error for invalid projection matrix")
+	}
+}
+
+func TestInstanceNormalize(t *testing.T) {
+	for _, s := range []struct {
+		name string
+		set  *Set
+	}{
+		{name: "Train", set: &Train},
+		{name: "Test", set: &Test},
+	} {
+		fs := s.set.InstanceNormalize()
+		if fs.Len() != s.set.Len() || fs.Dim() != s.set.Rows()*s.set.Cols() {
+			t.Fatalf("Unexpected shape for %q: got: %d×%d want: %d×%d",
+				s.name, fs.Len(), fs.Dim(), s.set.Len(), s.set.Rows()*s.set.Cols())
+		}
+		for i := 0; i < fs.Len(); i++ {
+			_, vec := fs.Index(i)
+			var mean float64
+			for _, v := range vec {
+				mean += float64(v)
+			}
+			mean /= float64(len(vec))
+			var variance float64
+			for _, v := range vec {
+				d := float64(v) - mean
+				variance += d * d
+			}
+			std := math.Sqrt(variance / float64(len(vec)))
+			if math.Abs(mean) > 1e-6 {
+				t.Fatalf("Unexpected mean for %q image %d: got: %v want: 0", s.name, i, mean)
+			}
+			if math.Abs(std-1) > 1e-6 {
+				t.Fatalf("Unexpected standard deviation for %q image %d: got: %v want: 1", s.name, i, std)
+			}
+		}
+	}
+}
+
+func TestChannelFirst(t *testing.T) {
+	fs := Test.ChannelFirst()
+	if fs.Channels() != 1 || fs.Rows() != 28 || fs.Cols() != 28 {
+		t.Fatalf("Unexpected shape: got: (%d, %d, %d) want: (1, 28, 28)", fs.Channels(), fs.Rows(), fs.Cols())
+	}
+	if fs.Len() != Test.Len() || fs.Dim() != 28*28 {
+		t.Fatalf("Unexpected size: got: %d×%d want: %d×%d", fs.Len(), fs.Dim(), Test.Len(), 28*28)
+	}
+	for i := 0; i < fs.Len(); i += 37 {
+		img := fs.ImageFloat32(i)
+		if len(img) != 1*28*28 {
+			t.Fatalf("Unexpected image length: got: %d want: %d", len(img), 28*28)
+		}
+		const ch = 0
+		for r := 0; r < fs.Rows(); r++ {
+			for c := 0; c < fs.Cols(); c++ {
+				got := img[(ch*fs.Rows()+r)*fs.Cols()+c]
+				want := float32(Test.At(i, r, c)) / 255.0
+				if got != want {
+					t.Fatalf("Unexpected value at image %d [%d, %d, %d]: got: %v want: %v", i, ch, r, c, got, want)
+				}
+			}
+		}
+		label, _ := fs.Index(i)
+		if want, _ := Test.Index(i); label != want {
+			t.Fatalf("Unexpected label for image %d: got: %d want: %d", i, label, want)
+		}
+	}
+
+	features := Test.ProjectFeatures([]int{1, 2, 3})
+	if features.Channels() != 1 || features.Rows() != 1 || features.Cols() != 3 {
+		t.Errorf("Unexpected feature shape: got: (%d, %d, %d) want: (1, 1, 3)", features.Channels(), features.Rows(), features.Cols())
+	}
+}
+
+func TestAsFloat32Set(t *testing.T) {
+	fs := Test.AsFloat32Set()
+	if fs.Len() != Test.Len() {
+		t.Errorf("Unexpected length: got: %d want: %d", fs.Len(), Test.Len())
+	}
+	dst := make([]float32, Test.Rows()*Test.Cols())
+	for _, i := range []int{0, 1, 100, Test.Len() - 1} {
+		want := Test.ImageFloat32(i)
+		got := fs.ImageFloat32(i)
+		if len(got) != len(want) {
+			t.Errorf("Unexpected image length for %d: got: %d want: %d", i, len(got), len(want))
+			continue
+		}
+		for j := range got {
+			if got[j] != want[j] {
+				t.Errorf("Unexpected value for image %d at %d: got: %v want: %v", i, j, got[j], want[j])
+				break
+			}
+		}
+		err := fs.ScanFloat32(i, dst)
+		if err != nil {
+			t.Errorf("Unexpected error scanning image %d: %v", i, err)
+		}
+		for j := range dst {
+			if dst[j] != want[j] {
+				t.Errorf("Unexpected scanned value for image %d at %d: got: %v want: %v", i, j, dst[j], want[j])
+				break
+			}
+		}
+		if label := fs.Label(i); label != Test.labels[i] {
+			t.Errorf("Unexpected label for image %d: got: %d want: %d", i, label, Test.labels[i])
+		}
+	}
+
+	// Images returned by ImageFloat32 do not alias each other.
+	a, b := fs.ImageFloat32(0), fs.ImageFloat32(1)
+	want := Test.ImageFloat32(0)
+	for j := range a {
+		if a[j] != want[j] {
+			t.Errorf("Unexpected value for image 0 at %d after reading image 1: got: %v want: %v", j, a[j], want[j])
+			break
+		}
+	}
+	if &a[0] == &b[0] {
+		t.Error("Unexpected aliasing of returned images")
+	}
+
+	allocs := testing.AllocsPerRun(10, func() {
+		v := Test.AsFloat32Set()
+		_ = v.Len()
+	})
+	if allocs != 0 {
+		t.Errorf("Unexpected allocations constructing view: got: %v want: 0", allocs)
+	}
+}
@@ -0,0 +1,72 @@
+// Copyright ©2026 The bíogo.nn Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mnist
+
+import (
+	"bytes"
+	"encoding/binary"
+	"testing"
+)
+
+// idxHeader returns an IDX header with the given magic number and dimensions.
+func idxHeader(magic int32, dims ...int32) []byte {
+	var buf bytes.Buffer
+	for _, v := range append([]int32{magic}, dims...) {
+		binary.Write(&buf, binary.BigEndian, v)
+	}
+	return buf.Bytes()
+}
+
+func FuzzReadIDXImages(f *testing.F) {
+	// Headers of the MNIST training and test image files.
+	f.Add(idxHeader(xIMG, 60000, 28, 28))
+	f.Add(idxHeader(xIMG, 10000, 28, 28))
+	s := subset(Test, 2)
+	var buf bytes.Buffer
+	err := s.encodeImages(&buf)
+	if err != nil {
+		f.Fatalf("Unexpected error encoding images: %v", err)
+	}
+	f.Add(buf.Bytes())
+	f.Add(idxHeader(xIMG, -1, 28, 28))
+	f.Add(idxHeader(xIMG, 0x7fffffff, 0x7fffffff, 0x7fffffff))
+
+	f.Fuzz(func(t *testing.T, data []byte) {
+		var s Set
+		err := s.decodeImages(bytes.NewReader(data))
+		if err != nil {
+			return
+		}
+		if want := int(s.count) * int(s.rows) * int(s.cols); len(s.matrix) != want {
+			t.Errorf("Unexpected image data length: got: %d want: %d", len(s.matrix), want)
+		}
+	})
+}
+
+func FuzzReadIDXLabels(f *testing.F) {
+	// Headers of the MNIST training and test label files.
+	f.Add(int32(60000), idxHeader(xLAB, 60000))
+	f.Add(int32(10000), idxHeader(xLAB, 10000))
+	s := subset(Test, 2)
+	var buf bytes.Buffer
+	err := s.encodeLabels(&buf)
+	if err != nil {
+		f.Fatalf("Unexpected error encoding labels: %v", err)
+	}
+	f.Add(int32(2), buf.Bytes())
+	f.Add(int32(-1), idxHeader(xLAB, -1))
+
+	f.Fuzz(func(t *testing.T, count int32, data []byte) {
+		// The image count of a set is never negative.
+		s := Set{count: max(count, 0)}
+		err := s.decodeLabels(bytes.NewReader(data))
+		if err != nil {
+			return
+		}
+		if len(s.labels) != int(s.count) {
+			t.Errorf("Unexpected label data length: got: %d want: %d", len(s.labels), s.count)
+		}
+	})
+}
@@ -0,0 +1,34 @@
+// Copyright ©2026 The bíogo.nn Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mnist
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+)
+
+// Hash returns the SHA-256 sum of the labels of the data set
+// followed by the pixels of all its images.
+func (s *Set) Hash() [32]byte {
+	h := sha256.New()
+	h.Write(s.labels)
+	h.Write(s.matrix)
+	var sum [32]byte
+	h.Sum(sum[:0])
+	return sum
+}
+
+// Checksum returns separate SHA-256 sums of the pixels of all the images
+// of the data set and of its labels.
+func (s *Set) Checksum() (imageSHA256, labelSHA256 [32]byte) {
+	return sha256.Sum256(s.matrix), sha256.Sum256(s.labels)
+}
+
+// Fingerprint returns a short identifier for the content of the data set,
+// formatted as "mnist-" followed by the first 8 bytes of Hash in hex.
+func (s *Set) Fingerprint() string {
+	h := s.Hash()
+	return "mnist-" + hex.EncodeToString(h[:8])
+}
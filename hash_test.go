@@ -0,0 +1,63 @@
+// Copyright ©2026 The bíogo.nn Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mnist
+
+import (
+	"crypto/sha256"
+	"regexp"
+	"testing"
+)
+
+func TestHash(t *testing.T) {
+	s := subset(Test, 100)
+	want := sha256.Sum256(append(append([]byte(nil), s.labels...), s.matrix...))
+	if got := s.Hash(); got != want {
+		t.Errorf("Unexpected hash: got: %x want: %x", got, want)
+	}
+}
+
+func TestChecksum(t *testing.T) {
+	s := subset(Test, 100)
+	images, labels := s.Checksum()
+	if want := sha256.Sum256(s.matrix); images != want {
+		t.Errorf("Unexpected image checksum: got: %x want: %x", images, want)
+	}
+	if want := sha256.Sum256(s.labels); labels != want {
+		t.Errorf("Unexpected label checksum: got: %x want: %x", labels, want)
+	}
+
+	relabeled := s
+	relabeled.labels = s.Labels()
+	relabeled.labels[0] = (relabeled.labels[0] + 1) % 10
+	gotImages, gotLabels := relabeled.Checksum()
+	if gotImages != images {
+		t.Error("Image checksum changed after label change")
+	}
+	if gotLabels == labels {
+		t.Error("Label checksum unchanged after label change")
+	}
+}
+
+func TestFingerprint(t *testing.T) {
+	valid := regexp.MustCompile(`^mnist-[0-9a-f]{16}$`)
+	train := Train.Fingerprint()
+	if !valid.MatchString(train) {
+		t.Errorf("Unexpected fingerprint format: %q", train)
+	}
+	if again := Train.Fingerprint(); again != train {
+		t.Errorf("Unstable fingerprint: got: %q then %q", train, again)
+	}
+	if test := Test.Fingerprint(); test == train {
+		t.Errorf("Unexpected matching fingerprints for Train and Test: %q", test)
+	}
+
+	flipped := subset(Test, 100)
+	before := flipped.Fingerprint()
+	flipped.labels = flipped.Labels()
+	flipped.labels[0] = (flipped.labels[0] + 1) % 10
+	if after := flipped.Fingerprint(); after == before {
+		t.Errorf("Fingerprint unchanged after label change: %q", after)
+	}
+}
@@ -0,0 +1,55 @@
+// Copyright ©2026 The bíogo.nn Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+//go:build hdf5
+
+package mnist
+
+import "gonum.org/v1/hdf5"
+
+// WriteHDF5 writes the data set to an HDF5 file at path. The file holds the
+// datasets "/images", with shape [count, rows, cols], and "/labels", with
+// shape [count], both with unsigned 8-bit integer elements.
+//
+// WriteHDF5 is only available when the package is built with the hdf5 build
+// tag and requires cgo and the HDF5 C library.
+func (s *Set) WriteHDF5(path string) (err error) {
+	f, err := hdf5.CreateFile(path, hdf5.F_ACC_TRUNC)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		cerr := f.Close()
+		if err == nil {
+			err = cerr
+		}
+	}()
+	err = writeHDF5Dataset(f, "images", []uint{uint(s.count), uint(s.rows), uint(s.cols)}, &s.matrix)
+	if err != nil {
+		return err
+	}
+	return writeHDF5Dataset(f, "labels", []uint{uint(s.count)}, &s.labels)
+}
+
+// writeHDF5Dataset writes the bytes in *data to a new uint8 dataset in f
+// with the given name and dimensions.
+func writeHDF5Dataset(f *hdf5.File, name string, dims []uint, data *[]byte) error {
+	space, err := hdf5.CreateSimpleDataspace(dims, nil)
+	if err != nil {
+		return err
+	}
+	defer space.Close()
+	d, err := f.CreateDataset(name, hdf5.T_NATIVE_UINT8, space)
+	if err != nil {
+		return err
+	}
+	if len(*data) != 0 {
+		err = d.Write(data)
+		if err != nil {
+			d.Close()
+			return err
+		}
+	}
+	return d.Close()
+}
@@ -0,0 +1,63 @@
+// Copyright ©2026 The bíogo.nn Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+//go:build hdf5
+
+package mnist
+
+import (
+	"bytes"
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	"gonum.org/v1/hdf5"
+)
+
+func TestWriteHDF5(t *testing.T) {
+	s := subset(Test, 1000)
+	path := filepath.Join(t.TempDir(), "mnist.h5")
+	err := s.WriteHDF5(path)
+	if err != nil {
+		t.Fatalf("Unexpected error writing HDF5: %v", err)
+	}
+	if !hdf5.IsHDF5(path) {
+		t.Fatal("Written file is not HDF5")
+	}
+
+	f, err := hdf5.OpenFile(path, hdf5.F_ACC_RDONLY)
+	if err != nil {
+		t.Fatalf("Unexpected error opening HDF5: %v", err)
+	}
+	defer f.Close()
+	for _, test := range []struct {
+		name string
+		dims []uint
+		want []byte
+	}{
+		{name: "images", dims: []uint{1000, 28, 28}, want: s.matrix},
+		{name: "labels", dims: []uint{1000}, want: s.labels},
+	} {
+		d, err := f.OpenDataset(test.name)
+		if err != nil {
+			t.Fatalf("Unexpected error opening %s dataset: %v", test.name, err)
+		}
+		dims, _, err := d.Space().SimpleExtentDims()
+		if err != nil {
+			t.Fatalf("Unexpected error reading %s dimensions: %v", test.name, err)
+		}
+		if !reflect.DeepEqual(dims, test.dims) {
+			t.Errorf("Unexpected %s dimensions: got: %v want: %v", test.name, dims, test.dims)
+		}
+		got := make([]byte, len(test.want))
+		err = d.Read(&got)
+		if err != nil {
+			t.Fatalf("Unexpected error reading %s: %v", test.name, err)
+		}
+		if !bytes.Equal(got, test.want) {
+			t.Errorf("Unexpected %s data", test.name)
+		}
+		d.Close()
+	}
+}
@@ -0,0 +1,26 @@
+// Copyright ©2026 The bíogo.nn Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+//go:build !mmap
+
+package mnist
+
+import (
+	"compress/gzip"
+	"os"
+)
+
+func (s *Set) readImages(file string) error {
+	f, err := os.Open(file)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	z, err := gzip.NewReader(f)
+	if err != nil {
+		return err
+	}
+	defer z.Close()
+	return s.decodeImages(z)
+}
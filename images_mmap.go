@@ -0,0 +1,111 @@
+// Copyright ©2026 The bíogo.nn Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+//go:build mmap
+
+package mnist
+
+import (
+	"compress/gzip"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// readImages memory-maps the image data held in the gzipped IDX file. Since
+// compressed data cannot be mapped, the file is first decompressed into a
+// sibling file without the .gz extension unless an up to date copy exists.
+// The mapping is never released and is copy-on-write, so modifications of
+// the pixel data are not written back to the file.
+func (s *Set) readImages(file string) error {
+	raw, err := decompress(file)
+	if err != nil {
+		return err
+	}
+	data, err := mapFile(raw)
+	if err != nil {
+		return err
+	}
+
+	const header = 16
+	if len(data) < header {
+		return io.ErrUnexpectedEOF
+	}
+	magic := int32(binary.BigEndian.Uint32(data))
+	if magic != xIMG {
+		return fmt.Errorf("invalid magic number for images: %x", magic)
+	}
+	s.count = int32(binary.BigEndian.Uint32(data[4:]))
+	s.rows = int32(binary.BigEndian.Uint32(data[8:]))
+	s.cols = int32(binary.BigEndian.Uint32(data[12:]))
+	if s.count < 0 || s.rows < 0 || s.cols < 0 {
+		return errors.New("invalid image dimensions")
+	}
+	stride := int64(s.rows) * int64(s.cols)
+	if stride != 0 && int64(s.count) > int64(len(data)-header)/stride {
+		return io.ErrUnexpectedEOF
+	}
+	n := int64(s.count) * stride
+	s.matrix = data[header : header+n : header+n]
+
+	return nil
+}
+
+// decompress writes the decompressed contents of the gzip file to a
+// sibling file without the .gz extension if that file does not exist
+// or is older than the gzip file, and returns the path to the
+// decompressed file.
+func decompress(file string) (string, error) {
+	raw := strings.TrimSuffix(file, ".gz")
+	if raw == file {
+		return "", errors.New("mnist: image file is not gzipped")
+	}
+	zfi, err := os.Stat(file)
+	if err != nil {
+		return "", err
+	}
+	rfi, err := os.Stat(raw)
+	if err == nil && !rfi.ModTime().Before(zfi.ModTime()) {
+		return raw, nil
+	}
+
+	f, err := os.Open(file)
+	if err != nil {
+		return "", err
+	}
+	defer f.Close()
+	z, err := gzip.NewReader(f)
+	if err != nil {
+		return "", err
+	}
+	defer z.Close()
+
+	// Write to a temporary file and rename so that a partially
+	// written file is never mistaken for a complete one.
+	tmp, err := os.CreateTemp(filepath.Dir(raw), filepath.Base(raw)+".*")
+	if err != nil {
+		return "", err
+	}
+	_, err = io.Copy(tmp, z)
+	if err != nil {
+		tmp.Close()
+		os.Remove(tmp.Name())
+		return "", err
+	}
+	err = tmp.Close()
+	if err != nil {
+		os.Remove(tmp.Name())
+		return "", err
+	}
+	err = os.Rename(tmp.Name(), raw)
+	if err != nil {
+		os.Remove(tmp.Name())
+		return "", err
+	}
+	return raw, nil
+}
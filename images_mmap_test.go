@@ -0,0 +1,134 @@
+// Copyright ©2026 The bíogo.nn Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+//go:build mmap && linux
+
+package mnist
+
+import (
+	"bufio"
+	"bytes"
+	"compress/gzip"
+	"encoding/binary"
+	"math"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"testing"
+)
+
+// rss returns the resident set size of the process in kB.
+func rss(t *testing.T) int {
+	f, err := os.Open("/proc/self/status")
+	if err != nil {
+		t.Skipf("cannot read process status: %v", err)
+	}
+	defer f.Close()
+	sc := bufio.NewScanner(f)
+	for sc.Scan() {
+		v, ok := strings.CutPrefix(sc.Text(), "VmRSS:")
+		if !ok {
+			continue
+		}
+		kb, err := strconv.Atoi(strings.TrimSpace(strings.TrimSuffix(strings.TrimSpace(v), "kB")))
+		if err != nil {
+			t.Fatalf("Unexpected VmRSS format: %q", v)
+		}
+		return kb
+	}
+	t.Skip("no VmRSS in process status")
+	return 0
+}
+
+func TestReadImagesMmap(t *testing.T) {
+	// Build a large image file by repeating the training set.
+	const repeats = 4
+	big := Set{
+		count: Train.count * repeats,
+		rows:  Train.rows,
+		cols:  Train.cols,
+	}
+	big.matrix = bytes.Repeat(Train.matrix, repeats)
+
+	path := filepath.Join(t.TempDir(), "images.gz")
+	f, err := os.Create(path)
+	if err != nil {
+		t.Fatalf("Unexpected error creating file: %v", err)
+	}
+	z := gzip.NewWriter(f)
+	err = big.encodeImages(z)
+	if err != nil {
+		t.Fatalf("Unexpected error encoding images: %v", err)
+	}
+	err = z.Close()
+	if err != nil {
+		t.Fatalf("Unexpected error compressing images: %v", err)
+	}
+	err = f.Close()
+	if err != nil {
+		t.Fatalf("Unexpected error closing file: %v", err)
+	}
+
+	before := rss(t)
+	var got Set
+	err = got.readImages(path)
+	if err != nil {
+		t.Fatalf("Unexpected error reading images: %v", err)
+	}
+	after := rss(t)
+	if grown, size := (after-before)*1024, len(big.matrix); grown > size/4 {
+		t.Errorf("Unexpected RSS growth at load: got: %d bytes for %d bytes of image data", grown, size)
+	}
+
+	if got.Len() != big.Len() || got.Rows() != big.Rows() || got.Cols() != big.Cols() {
+		t.Fatalf("Unexpected shape: got: %d×%d×%d want: %d×%d×%d",
+			got.Len(), got.Rows(), got.Cols(), big.Len(), big.Rows(), big.Cols())
+	}
+	got.labels = make([]byte, got.count)
+	big.labels = got.labels
+	for _, i := range []int{0, 1, 100, big.Len() / 2, big.Len() - 1} {
+		_, gotImg := got.Index(i)
+		_, wantImg := big.Index(i)
+		if !bytes.Equal(gotImg, wantImg) {
+			t.Errorf("Unexpected pixels for image %d", i)
+		}
+	}
+}
+
+func TestReadImagesMmapInvalidHeader(t *testing.T) {
+	for _, test := range []struct {
+		count, rows, cols int32
+	}{
+		{count: -1, rows: -1, cols: 784},
+		{count: -1, rows: 28, cols: 28},
+		{count: 1, rows: -28, cols: -28},
+		{count: 1, rows: 28, cols: -1},
+		{count: math.MaxInt32, rows: math.MaxInt32, cols: math.MaxInt32},
+		{count: 2, rows: 28, cols: 28},
+	} {
+		path := filepath.Join(t.TempDir(), "images.gz")
+		f, err := os.Create(path)
+		if err != nil {
+			t.Fatalf("Unexpected error creating file: %v", err)
+		}
+		z := gzip.NewWriter(f)
+		for _, v := range []int32{xIMG, test.count, test.rows, test.cols} {
+			binary.Write(z, binary.BigEndian, v)
+		}
+		z.Write(make([]byte, 784))
+		err = z.Close()
+		if err != nil {
+			t.Fatalf("Unexpected error compressing images: %v", err)
+		}
+		f.Close()
+
+		var got Set
+		err = got.readImages(path)
+		if err == nil {
+			t.Errorf("Expected error for %d×%d×%d header: got Set with Len %d",
+				test.count, test.rows, test.cols, got.Len())
+		}
+	}
+}
@@ -0,0 +1,52 @@
+// Copyright ©2026 The bíogo.nn Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mnist
+
+import "math/rand"
+
+// OneHot returns the one-hot encoding of the labels of the data set. Element
+// [i][c] of the returned matrix is 1 if the label of the i'th sample is c and
+// 0 otherwise.
+func (s *Set) OneHot() [][]float64 {
+	return s.LabelSmoothing(0)
+}
+
+// LabelSmoothing returns the smoothed one-hot encoding of the labels of the data
+// set, as described by Szegedy et al. "Rethinking the Inception Architecture for
+// Computer Vision" CVPR 2016. Element [i][c] of the returned matrix is
+// (1-epsilon)*onehot[i][c] + epsilon/10.
+func (s *Set) LabelSmoothing(epsilon float64) [][]float64 {
+	data := make([]float64, s.Len()*numClasses)
+	m := make([][]float64, s.Len())
+	for i, l := range s.labels {
+		row := data[i*numClasses : (i+1)*numClasses : (i+1)*numClasses]
+		for c := range row {
+			row[c] = epsilon / numClasses
+		}
+		row[l] += 1 - epsilon
+		m[i] = row
+	}
+	return m
+}
+
+// FlipLabels returns a copy of the data set where each label is independently
+// replaced with probability prob by a uniformly chosen different class. The
+// image data of the returned Set is shared with s.
+func (s *Set) FlipLabels(prob float64, r *rand.Rand) Set {
+	c := *s
+	c.labels = make([]byte, len(s.labels))
+	for i, l := range s.labels {
+		if r.Float64() < prob {
+			// Choose from the other numClasses-1 classes.
+			f := byte(r.Intn(numClasses - 1))
+			if f >= l {
+				f++
+			}
+			l = f
+		}
+		c.labels[i] = l
+	}
+	return c
+}
@@ -0,0 +1,127 @@
+// Copyright ©2026 The bíogo.nn Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mnist
+
+import (
+	"bytes"
+	"math"
+	"math/rand"
+	"testing"
+)
+
+func TestLabelSmoothing(t *testing.T) {
+	onehot := Test.OneHot()
+	if len(onehot) != Test.Len() {
+		t.Fatalf("Unexpected number of one-hot rows: got: %d want: %d", len(onehot), Test.Len())
+	}
+	for i, row := range onehot {
+		label, _ := Test.Index(i)
+		for c, v := range row {
+			want := 0.0
+			if c == int(label) {
+				want = 1
+			}
+			if v != want {
+				t.Fatalf("Unexpected one-hot value at [%d][%d]: got: %v want: %v", i, c, v, want)
+			}
+		}
+	}
+
+	for _, test := range []struct {
+		epsilon float64
+		check   func(i int, row []float64)
+	}{
+		{
+			epsilon: 0,
+			check: func(i int, row []float64) {
+				for c, v := range row {
+					if v != onehot[i][c] {
+						t.Fatalf("Unexpected value at [%d][%d] for epsilon=0: got: %v want: %v", i, c, v, onehot[i][c])
+					}
+				}
+			},
+		},
+		{
+			epsilon: 0.1,
+			check: func(i int, row []float64) {
+				label, _ := Test.Index(i)
+				for c, v := range row {
+					want := 0.01
+					if c == int(label) {
+						want = 0.91
+					}
+					if math.Abs(v-want) > 1e-12 {
+						t.Fatalf("Unexpected value at [%d][%d] for epsilon=0.1: got: %v want: %v", i, c, v, want)
+					}
+				}
+			},
+		},
+		{
+			epsilon: 1,
+			check: func(i int, row []float64) {
+				for c, v := range row {
+					if v != 0.1 {
+						t.Fatalf("Unexpected value at [%d][%d] for epsilon=1: got: %v want: 0.1", i, c, v)
+					}
+				}
+			},
+		},
+	} {
+		m := Test.LabelSmoothing(test.epsilon)
+		if len(m) != Test.Len() {
+			t.Fatalf("Unexpected number of rows for epsilon=%v: got: %d want: %d", test.epsilon, len(m), Test.Len())
+		}
+		for i, row := range m {
+			if len(row) != 10 {
+				t.Fatalf("Unexpected row length for epsilon=%v: got: %d want: 10", test.epsilon, len(row))
+			}
+			var sum float64
+			for _, v := range row {
+				sum += v
+			}
+			if math.Abs(sum-1) > 1e-12 {
+				t.Errorf("Unexpected row sum at %d for epsilon=%v: got: %v want: 1", i, test.epsilon, sum)
+			}
+			test.check(i, row)
+		}
+	}
+}
+
+func TestFlipLabels(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+
+	got := Test.FlipLabels(0, r)
+	if !bytes.Equal(got.labels, Test.labels) {
+		t.Error("Unexpected label change with prob=0")
+	}
+
+	got = Test.FlipLabels(1, r)
+	var counts [256]int
+	for i, l := range got.labels {
+		if l == Test.labels[i] {
+			t.Errorf("Unexpected unflipped label at %d with prob=1", i)
+		}
+		counts[l]++
+	}
+	for l, n := range counts {
+		if (l < 10) != (n != 0) {
+			t.Errorf("Unexpected count for label %d with prob=1: %d", l, n)
+		}
+	}
+
+	got = Test.FlipLabels(0.2, r)
+	var flipped int
+	for i, l := range got.labels {
+		if l != Test.labels[i] {
+			flipped++
+		}
+	}
+	if frac := float64(flipped) / float64(Test.Len()); math.Abs(frac-0.2) > 0.02 {
+		t.Errorf("Unexpected flipped fraction with prob=0.2: got: %v", frac)
+	}
+	if !bytes.Equal(got.matrix, Test.matrix) {
+		t.Error("Unexpected image change")
+	}
+}
@@ -0,0 +1,46 @@
+// Copyright ©2026 The bíogo.nn Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mnist
+
+// channels is the number of channels in MNIST images.
+const channels = 1
+
+// ToNHWC returns the images of the data set as a flat tensor in NHWC
+// (batch, height, width, channel) order with pixel values scaled to [0,1].
+// MNIST images have a single channel, so the tensor has length
+// s.Len()*s.Rows()*s.Cols() and the pixel at row y and column x of the
+// n'th image is at offset ((n*rows+y)*cols+x)*1+0.
+func (s *Set) ToNHWC() []float32 {
+	return s.tensor(func(n, y, x int) int {
+		return (n*s.Rows()+y)*s.Cols()*channels + x*channels
+	})
+}
+
+// ToNCHW returns the images of the data set as a flat tensor in NCHW
+// (batch, channel, height, width) order with pixel values scaled to [0,1].
+// MNIST images have a single channel, so the tensor has length
+// s.Len()*s.Rows()*s.Cols() and the pixel at row y and column x of the
+// n'th image is at offset ((n*1+0)*rows+y)*cols+x.
+func (s *Set) ToNCHW() []float32 {
+	return s.tensor(func(n, y, x int) int {
+		return (n*channels*s.Rows()+y)*s.Cols() + x
+	})
+}
+
+// tensor returns the scaled pixels of the data set placed at the
+// offsets returned by offset for each image, row and column.
+func (s *Set) tensor(offset func(n, y, x int) int) []float32 {
+	rows, cols := s.Dimensions()
+	t := make([]float32, s.Len()*rows*cols)
+	for n := 0; n < s.Len(); n++ {
+		_, img := s.Index(n)
+		for y := 0; y < rows; y++ {
+			for x := 0; x < cols; x++ {
+				t[offset(n, y, x)] = float32(img[y*cols+x]) / 255
+			}
+		}
+	}
+	return t
+}
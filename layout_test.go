@@ -0,0 +1,43 @@
+// Copyright ©2026 The bíogo.nn Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mnist
+
+import "testing"
+
+func TestLayout(t *testing.T) {
+	for _, s := range []struct {
+		name string
+		set  *Set
+	}{
+		{name: "Train", set: &Train},
+		{name: "Test", set: &Test},
+	} {
+		rows, cols := s.set.Dimensions()
+		nhwc := s.set.ToNHWC()
+		nchw := s.set.ToNCHW()
+		want := s.set.Len() * rows * cols * channels
+		if len(nhwc) != want {
+			t.Errorf("Unexpected NHWC length for %q: got: %d want: %d", s.name, len(nhwc), want)
+		}
+		if len(nchw) != want {
+			t.Errorf("Unexpected NCHW length for %q: got: %d want: %d", s.name, len(nchw), want)
+		}
+		for n := 0; n < s.set.Len(); n += 97 {
+			_, img := s.set.Index(n)
+			for y := 0; y < rows; y++ {
+				for x := 0; x < cols; x++ {
+					const c = 0
+					v := float32(img[y*cols+x]) / 255
+					if got := nhwc[((n*rows+y)*cols+x)*channels+c]; got != v {
+						t.Fatalf("Unexpected NHWC value for %q at (%d,%d,%d,%d): got: %v want: %v", s.name, n, y, x, c, got, v)
+					}
+					if got := nchw[((n*channels+c)*rows+y)*cols+x]; got != v {
+						t.Fatalf("Unexpected NCHW value for %q at (%d,%d,%d,%d): got: %v want: %v", s.name, n, c, y, x, got, v)
+					}
+				}
+			}
+		}
+	}
+}
@@ -0,0 +1,40 @@
+// Copyright ©2026 The bíogo.nn Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mnist
+
+import (
+	"bufio"
+	"io"
+	"strconv"
+)
+
+// ToSparseLibSVM writes the data set to w in the sparse LibSVM text format.
+// Each sample is written on a line holding its label followed by space
+// separated index:value pairs for each pixel with a value greater than
+// zerothreshold. Indices are one-based positions in the row-major image
+// and values are the raw pixel values.
+func (s *Set) ToSparseLibSVM(w io.Writer, zerothreshold byte) error {
+	bw := bufio.NewWriter(w)
+	var buf []byte
+	for i := 0; i < s.Len(); i++ {
+		label, img := s.Index(i)
+		buf = strconv.AppendUint(buf[:0], uint64(label), 10)
+		for p, v := range img {
+			if v <= zerothreshold {
+				continue
+			}
+			buf = append(buf, ' ')
+			buf = strconv.AppendInt(buf, int64(p+1), 10)
+			buf = append(buf, ':')
+			buf = strconv.AppendUint(buf, uint64(v), 10)
+		}
+		buf = append(buf, '\n')
+		_, err := bw.Write(buf)
+		if err != nil {
+			return err
+		}
+	}
+	return bw.Flush()
+}
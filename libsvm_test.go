@@ -0,0 +1,83 @@
+// Copyright ©2026 The bíogo.nn Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mnist
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"strconv"
+	"strings"
+	"testing"
+)
+
+func TestToSparseLibSVM(t *testing.T) {
+	for _, threshold := range []byte{0, 64} {
+		var sparse bytes.Buffer
+		err := Test.ToSparseLibSVM(&sparse, threshold)
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+
+		var dense bytes.Buffer
+		for i := 0; i < Test.Len(); i++ {
+			label, img := Test.Index(i)
+			fmt.Fprint(&dense, label)
+			for _, v := range img {
+				fmt.Fprintf(&dense, ",%d", v)
+			}
+			dense.WriteByte('\n')
+		}
+		if sparse.Len() >= dense.Len() {
+			t.Errorf("Sparse output not smaller than dense CSV for threshold %d: got: %d bytes dense: %d bytes",
+				threshold, sparse.Len(), dense.Len())
+		}
+
+		sc := bufio.NewScanner(&sparse)
+		var i int
+		for ; sc.Scan(); i++ {
+			fields := strings.Fields(sc.Text())
+			label, err := strconv.ParseUint(fields[0], 10, 8)
+			if err != nil {
+				t.Fatalf("Unexpected error parsing label on line %d: %v", i, err)
+			}
+			got := make([]byte, Test.Rows()*Test.Cols())
+			for _, f := range fields[1:] {
+				idx, val, ok := strings.Cut(f, ":")
+				if !ok {
+					t.Fatalf("Invalid pair on line %d: %q", i, f)
+				}
+				p, err := strconv.Atoi(idx)
+				if err != nil {
+					t.Fatalf("Unexpected error parsing index on line %d: %v", i, err)
+				}
+				v, err := strconv.ParseUint(val, 10, 8)
+				if err != nil {
+					t.Fatalf("Unexpected error parsing value on line %d: %v", i, err)
+				}
+				got[p-1] = byte(v)
+			}
+
+			wantLabel, img := Test.Index(i)
+			if byte(label) != wantLabel {
+				t.Fatalf("Unexpected label on line %d: got: %d want: %d", i, label, wantLabel)
+			}
+			for p, v := range img {
+				if v <= threshold {
+					v = 0
+				}
+				if got[p] != v {
+					t.Fatalf("Unexpected pixel %d on line %d for threshold %d: got: %d want: %d", p, i, threshold, got[p], v)
+				}
+			}
+		}
+		if err := sc.Err(); err != nil {
+			t.Fatalf("Unexpected error scanning output: %v", err)
+		}
+		if i != Test.Len() {
+			t.Errorf("Unexpected number of lines: got: %d want: %d", i, Test.Len())
+		}
+	}
+}
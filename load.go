@@ -0,0 +1,550 @@
+// Copyright ©2026 The bíogo.nn Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mnist
+
+import (
+	"crypto/md5"
+	"crypto/sha256"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"runtime"
+	"time"
+)
+
+// An Option modifies the behaviour of Load.
+type Option func(*config)
+
+type config struct {
+	dir       string
+	local     []string
+	client    *http.Client
+	mirrors   []string
+	offline   bool
+	verify    bool
+	progress  func(filename string, downloaded, total int64)
+	chunkSize int64
+	s3        func(bucket, key string) (io.ReadCloser, error) // set by WithS3Client
+}
+
+func newConfig(opts []Option) *config {
+	cfg := config{
+		client:    &http.Client{},
+		chunkSize: defaultChunkSize,
+	}
+	for _, o := range opts {
+		o(&cfg)
+	}
+	return &cfg
+}
+
+// defaultChunkSize is the default number of bytes downloaded
+// between calls to a progress function.
+const defaultChunkSize = 1 << 20
+
+// WithDataDir returns an Option that sets the directory used to hold the data
+// files, instead of the package's root directory. The directory is created if
+// it does not exist. A lock file, .mnist.lock, is created in the directory
+// when data files are downloaded.
+func WithDataDir(dir string) Option {
+	return func(c *config) { c.dir = dir }
+}
+
+// WithEnvDir returns an Option that sets the directory used to hold the data
+// files to the value of the environment variable envVar. If envVar is unset
+// or empty, the option has no effect.
+func WithEnvDir(envVar string) Option {
+	return func(c *config) {
+		if dir := os.Getenv(envVar); dir != "" {
+			c.dir = dir
+		}
+	}
+}
+
+// WithLocalFiles returns an Option that causes the data to be read directly
+// from the given gzipped IDX files instead of from the data directory. No
+// download is attempted. The files are only checked against the expected
+// checksums when VerifyOnLoad is also given.
+func WithLocalFiles(imageFile, labelFile, testImageFile, testLabelFile string) Option {
+	return func(c *config) {
+		c.local = []string{imageFile, labelFile, testImageFile, testLabelFile}
+	}
+}
+
+// WithHTTPClient returns an Option that causes data files to be downloaded
+// using client instead of a default http.Client.
+func WithHTTPClient(client *http.Client) Option {
+	return func(c *config) {
+		if client != nil {
+			c.client = client
+		}
+	}
+}
+
+// WithMirrors returns an Option that provides alternative base URLs to download
+// data files from when downloading from the primary location fails. Mirrors are
+// tried in order, with the name of the data file appended to each base URL.
+// Base URLs with the file scheme refer to local directories.
+func WithMirrors(urls ...string) Option {
+	return func(c *config) { c.mirrors = append(c.mirrors, urls...) }
+}
+
+// OfflineMode returns an Option that prevents any network access. When
+// offline, Load and New return an error if any data file is missing or
+// invalid, so data files that were downloaded earlier, or copied into the
+// data directory, can be used on machines without network access.
+func OfflineMode() Option {
+	return func(c *config) { c.offline = true }
+}
+
+// VerifyOnLoad returns an Option that makes data file checksum failures an
+// error. By default a data file that is present with the expected length but
+// an invalid checksum is silently downloaded again. With VerifyOnLoad the
+// mismatch is reported as an error, and downloaded files are also verified.
+func VerifyOnLoad() Option {
+	return func(c *config) { c.verify = true }
+}
+
+// WithProgressFunc returns an Option that causes fn to be called periodically
+// while a data file is being downloaded. The filename is the base name of the
+// file being downloaded, downloaded is the number of bytes received so far and
+// total is the expected length of the file. fn is called after each chunk of
+// bytes is received, and a final time when the download is complete.
+func WithProgressFunc(fn func(filename string, downloaded, total int64)) Option {
+	return func(c *config) { c.progress = fn }
+}
+
+// WithProgressChunkSize returns an Option that sets the number of bytes
+// received between calls to the function set by WithProgressFunc.
+// The default is 1MiB.
+func WithProgressChunkSize(n int64) Option {
+	return func(c *config) {
+		if n > 0 {
+			c.chunkSize = n
+		}
+	}
+}
+
+// Load reads the MNIST data into Train and Test, first downloading the data
+// files into the package's root directory, or the directory set by WithDataDir,
+// if they are not already present. Load must be called before Train and Test
+// are used.
+func Load(opts ...Option) error {
+	train, test, err := New(opts...)
+	if err != nil {
+		return err
+	}
+	Train, Test = train, test
+	return nil
+}
+
+// New returns the MNIST training and test sets, first downloading the data
+// files into the package's root directory, or the directory set by WithDataDir,
+// if they are not already present. Unlike Load, New neither reads nor alters
+// Train and Test, so it may be used without calling Load and may be called
+// concurrently with different options.
+func New(opts ...Option) (train, test Set, err error) {
+	cfg := newConfig(opts)
+
+	local := cfg.local
+	sources := local
+	if local == nil {
+		sources = []string{mnist[0].url, mnist[1].url, mnist[2].url, mnist[3].url}
+		local, err = fetchAll(cfg)
+		if err != nil {
+			return Set{}, Set{}, err
+		}
+	} else if cfg.verify {
+		for i, f := range mnist {
+			err = verifyFile(local[i], f)
+			if err != nil {
+				return Set{}, Set{}, fmt.Errorf("%s: %w", local[i], err)
+			}
+		}
+	}
+
+	err = train.read(local[0], local[1])
+	if err != nil {
+		return Set{}, Set{}, err
+	}
+	err = test.read(local[2], local[3])
+	if err != nil {
+		return Set{}, Set{}, err
+	}
+	now := time.Now()
+	train.meta = Metadata{Source: sources[0], LoadedAt: now}
+	test.meta = Metadata{Source: sources[2], LoadedAt: now}
+	return train, test, nil
+}
+
+// fetchAll ensures that all the data files are present in the data
+// directory, downloading them if necessary, and returns their paths.
+func fetchAll(cfg *config) ([]string, error) {
+	dir, err := cfg.dataDir()
+	if err != nil {
+		return nil, err
+	}
+	err = os.MkdirAll(dir, 0o755)
+	if err != nil {
+		return nil, err
+	}
+
+	logInfo("checking for MNIST data")
+	local := make([]string, len(mnist))
+	for i, f := range mnist {
+		local[i], err = fetch(cfg, f, dir)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return local, nil
+}
+
+// dataDir returns the directory holding the data files, the directory
+// set by WithDataDir or WithEnvDir or else the package's root directory.
+func (c *config) dataDir() (string, error) {
+	if c.dir != "" {
+		return c.dir, nil
+	}
+	return packageDir()
+}
+
+// Verify checks the integrity of the data files against their expected
+// lengths, SHA-256 and MD5 sums. The files are found in the same way as
+// by Load and New, in the package's root directory, the directory set by
+// WithDataDir or WithEnvDir, or at the paths given by WithLocalFiles.
+// Options that do not affect the location of the files are ignored.
+// Verify returns an error describing each file that is missing or invalid.
+func Verify(opts ...Option) error {
+	cfg := newConfig(opts)
+	if cfg.local != nil {
+		var errs []error
+		for i, f := range mnist {
+			err := verifyFile(cfg.local[i], f)
+			if err != nil {
+				errs = append(errs, fmt.Errorf("%s: %w", cfg.local[i], err))
+			}
+		}
+		return errors.Join(errs...)
+	}
+	dir, err := cfg.dataDir()
+	if err != nil {
+		return err
+	}
+	return verifyFiles(dir, mnist)
+}
+
+func verifyFiles(dir string, files []dataFile) error {
+	var errs []error
+	for _, f := range files {
+		u, err := url.Parse(f.url)
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		fn := filepath.Base(u.Path)
+		err = verifyFile(filepath.Join(dir, fn), f)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("%s: %w", fn, err))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// LoadFromHTTP returns a Set read from the gzipped IDX image and label files
+// at imageURL and labelURL, without altering Train and Test. The files are
+// retrieved in the same way as the MNIST data files, so options for the HTTP
+// client, mirrors, offline mode and progress reporting apply. Since the
+// expected checksums of the files are not known, they are not verified.
+// If WithDataDir is given the files are kept in that directory and are
+// reused by later calls, otherwise they are removed once they have been read.
+func LoadFromHTTP(imageURL, labelURL string, opts ...Option) (Set, error) {
+	return loadURLs(imageURL, labelURL, newConfig(opts))
+}
+
+// loadURLs returns a Set read from the gzipped IDX files at imageURL and
+// labelURL, retrieving them as described for LoadFromHTTP.
+func loadURLs(imageURL, labelURL string, cfg *config) (Set, error) {
+	dir := cfg.dir
+	if dir == "" {
+		tmp, err := os.MkdirTemp("", "mnist-")
+		if err != nil {
+			return Set{}, err
+		}
+		defer os.RemoveAll(tmp)
+		dir = tmp
+	} else {
+		err := os.MkdirAll(dir, 0o755)
+		if err != nil {
+			return Set{}, err
+		}
+	}
+
+	var local [2]string
+	for i, src := range []string{imageURL, labelURL} {
+		var err error
+		local[i], err = fetch(cfg, dataFile{url: src}, dir)
+		if err != nil {
+			return Set{}, err
+		}
+	}
+	if local[0] == local[1] {
+		return Set{}, errors.New("mnist: image and label files have the same name")
+	}
+
+	var s Set
+	err := s.read(local[0], local[1])
+	if err != nil {
+		return Set{}, err
+	}
+	s.meta = Metadata{Source: imageURL, LoadedAt: time.Now()}
+	return s, nil
+}
+
+// packageDir returns the package's root directory.
+func packageDir() (string, error) {
+	_, path, _, ok := runtime.Caller(0)
+	if !ok {
+		return "", errors.New("cannot get file location")
+	}
+	return filepath.Dir(path), nil
+}
+
+// lockName is the name of the file in a data directory that is locked
+// while data files are downloaded into the directory. The file is left
+// in place after the lock is released, since removing it would allow a
+// process waiting on the removed file and a process locking a newly
+// created file to both hold the lock.
+const lockName = ".mnist.lock"
+
+// fetch ensures that the data file described by f is present in dir,
+// downloading it if necessary, and returns the path to the local file.
+func fetch(cfg *config, f dataFile, dir string) (string, error) {
+	u, err := url.Parse(f.url)
+	if err != nil {
+		return "", err
+	}
+	fn := filepath.Base(u.Path)
+	local := filepath.Join(dir, localName(u))
+	err = verifyFile(local, f)
+	if err == nil {
+		logInfo("data file", "file", fn, "status", "ok")
+		return local, nil
+	}
+	if cfg.verify && errors.Is(err, errChecksum) {
+		logError("data file", "file", fn, "status", "invalid", "error", err)
+		return "", fmt.Errorf("%s: %w", fn, err)
+	}
+	if cfg.offline {
+		logError("data file", "file", fn, "status", "unavailable offline")
+		return "", fmt.Errorf("%s: missing or invalid data file in offline mode", fn)
+	}
+
+	// Hold a lock while downloading so that processes sharing the
+	// data directory do not write to the file at the same time. A
+	// process that waited for the lock checks whether the file was
+	// completed by the lock holder before downloading it again.
+	unlock, err := lockFile(filepath.Join(dir, lockName))
+	if err != nil {
+		return "", fmt.Errorf("%s: %w", fn, err)
+	}
+	defer unlock()
+	if verifyFile(local, f) == nil {
+		logInfo("data file", "file", fn, "status", "ok")
+		return local, nil
+	}
+
+	var errs []error
+	for _, src := range append([]string{f.url}, mirrorURLs(cfg.mirrors, fn)...) {
+		logInfo("data file", "file", fn, "status", "downloading", "url", src)
+		err = download(cfg, src, local, fn, f.length)
+		if err == nil && cfg.verify {
+			err = verifyFile(local, f)
+		}
+		if err == nil {
+			logInfo("data file", "file", fn, "status", "downloaded")
+			return local, nil
+		}
+		logError("data file", "file", fn, "status", "failed", "url", src, "error", err)
+		errs = append(errs, err)
+	}
+	return "", fmt.Errorf("%s: download failed: %w", fn, errors.Join(errs...))
+}
+
+// localName returns the name of the file used to hold the data at u in a
+// data directory. Data retrieved by URL is held under the base name of
+// the URL's path. S3 objects are held under the escaped bucket and full
+// key so that objects with the same base name do not collide.
+func localName(u *url.URL) string {
+	if u.Scheme == "s3" {
+		return url.QueryEscape(u.Host + u.Path)
+	}
+	return filepath.Base(u.Path)
+}
+
+// mirrorURLs returns the URLs for the file name fn at each of the mirror base URLs.
+func mirrorURLs(mirrors []string, fn string) []string {
+	urls := make([]string, 0, len(mirrors))
+	for _, m := range mirrors {
+		u, err := url.JoinPath(m, fn)
+		if err != nil {
+			continue
+		}
+		urls = append(urls, u)
+	}
+	return urls
+}
+
+// download retrieves the data file named fn from src into the local
+// file dst, checking that the file has the expected length if it is
+// known. The data is written to a temporary file that is renamed to
+// dst on success, so a failed download never leaves a partial file
+// that could be mistaken for a complete one.
+func download(cfg *config, src, dst, fn string, length int64) error {
+	body, err := open(cfg, src)
+	if err != nil {
+		return err
+	}
+	defer body.Close()
+	f, err := os.CreateTemp(filepath.Dir(dst), filepath.Base(dst)+".*")
+	if err != nil {
+		return err
+	}
+	var w io.Writer = f
+	var p *progressWriter
+	if cfg.progress != nil {
+		p = &progressWriter{w: f, name: fn, total: length, chunk: cfg.chunkSize, fn: cfg.progress}
+		w = p
+	}
+	n, err := io.Copy(w, body)
+	if err != nil {
+		f.Close()
+		os.Remove(f.Name())
+		return err
+	}
+	err = f.Close()
+	if err != nil {
+		os.Remove(f.Name())
+		return err
+	}
+	if p != nil {
+		p.done()
+	}
+	if length > 0 && n != length {
+		os.Remove(f.Name())
+		return fmt.Errorf("length mismatch %d != %d", n, length)
+	}
+	err = os.Rename(f.Name(), dst)
+	if err != nil {
+		os.Remove(f.Name())
+		return err
+	}
+	return nil
+}
+
+// open returns a reader for the data at src. URLs with the file scheme
+// are opened directly, URLs with the s3 scheme are retrieved from S3 if
+// the package is built with the s3 tag and all others are retrieved with
+// cfg's client.
+func open(cfg *config, src string) (io.ReadCloser, error) {
+	u, err := url.Parse(src)
+	if err != nil {
+		return nil, err
+	}
+	switch u.Scheme {
+	case "file":
+		return os.Open(filePath(u))
+	case "s3":
+		return openS3(cfg, u)
+	}
+	res, err := cfg.client.Get(src)
+	if err != nil {
+		return nil, err
+	}
+	if res.StatusCode != http.StatusOK {
+		res.Body.Close()
+		return nil, fmt.Errorf("unexpected status: %s", res.Status)
+	}
+	return res.Body, nil
+}
+
+// filePath returns the local path for the file scheme URL u. On Windows,
+// the slash preceding a drive letter, as in file:///C:/data/x.gz, is
+// removed so that the path begins with the volume name.
+func filePath(u *url.URL) string {
+	p := u.Path
+	if len(p) > 1 && p[0] == '/' && filepath.VolumeName(filepath.FromSlash(p[1:])) != "" {
+		p = p[1:]
+	}
+	return filepath.FromSlash(p)
+}
+
+var errChecksum = errors.New("checksum mismatch")
+
+// verifyFile returns an error if the file at path does not have the
+// length, MD5 and SHA-256 sums described by f. Only the properties
+// that are known, those with non-zero values in f, are checked.
+func verifyFile(path string, f dataFile) error {
+	r, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer r.Close()
+	fs, err := r.Stat()
+	if err != nil {
+		return err
+	}
+	if f.length > 0 && fs.Size() != f.length {
+		return fmt.Errorf("length mismatch %d != %d", fs.Size(), f.length)
+	}
+	md5sum := md5.New()
+	sha256sum := sha256.New()
+	_, err = io.Copy(io.MultiWriter(md5sum, sha256sum), r)
+	if err != nil {
+		return err
+	}
+	if f.md5 != "" && fmt.Sprintf("%x", md5sum.Sum(nil)) != f.md5 {
+		return errChecksum
+	}
+	if f.sha256 != "" && fmt.Sprintf("%x", sha256sum.Sum(nil)) != f.sha256 {
+		return errChecksum
+	}
+	return nil
+}
+
+// progressWriter is an io.Writer that reports the number of
+// bytes written after each chunk of bytes is written.
+type progressWriter struct {
+	w     io.Writer
+	name  string
+	total int64
+	chunk int64
+	fn    func(filename string, downloaded, total int64)
+
+	n, reported int64
+}
+
+func (p *progressWriter) Write(b []byte) (int, error) {
+	n, err := p.w.Write(b)
+	p.n += int64(n)
+	if p.n-p.reported >= p.chunk {
+		p.fn(p.name, p.n, p.total)
+		p.reported = p.n
+	}
+	return n, err
+}
+
+// done reports the final number of bytes written if it
+// has not already been reported.
+func (p *progressWriter) done() {
+	if p.n != p.reported {
+		p.fn(p.name, p.n, p.total)
+		p.reported = p.n
+	}
+}
@@ -0,0 +1,892 @@
+// Copyright ©2026 The bíogo.nn Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mnist
+
+import (
+	"bytes"
+	"compress/gzip"
+	"crypto/md5"
+	"crypto/sha256"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"log/slog"
+	"math/rand"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"runtime"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+)
+
+// testServer returns a server that serves data at /data.gz and a
+// dataFile describing it.
+func testServer(t *testing.T, data []byte) (*httptest.Server, dataFile) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/data.gz" {
+			http.NotFound(w, r)
+			return
+		}
+		w.Write(data)
+	}))
+	t.Cleanup(srv.Close)
+	return srv, dataFile{
+		url:    srv.URL + "/data.gz",
+		length: int64(len(data)),
+		md5:    fmt.Sprintf("%x", md5.Sum(data)),
+	}
+}
+
+// serveTestData serves gzipped IDX files holding train and test, and
+// replaces the package's data file descriptors with descriptors for
+// the served files for the duration of the test. It returns a count
+// of the requests for served files.
+func serveTestData(t *testing.T, train, test Set) *atomic.Int64 {
+	files := make(map[string][]byte)
+	var descs []dataFile
+	var requests atomic.Int64
+	// The files are added before the server is started so that
+	// requests from other processes do not race with the writes.
+	srv := httptest.NewUnstartedServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		data, ok := files[r.URL.Path]
+		if !ok {
+			http.NotFound(w, r)
+			return
+		}
+		requests.Add(1)
+		w.Write(data)
+	}))
+	for _, f := range []struct {
+		name   string
+		encode func(io.Writer) error
+	}{
+		{name: "/train-images.gz", encode: train.encodeImages},
+		{name: "/train-labels.gz", encode: train.encodeLabels},
+		{name: "/test-images.gz", encode: test.encodeImages},
+		{name: "/test-labels.gz", encode: test.encodeLabels},
+	} {
+		var buf bytes.Buffer
+		z := gzip.NewWriter(&buf)
+		err := f.encode(z)
+		if err != nil {
+			t.Fatalf("Unexpected error encoding %s: %v", f.name, err)
+		}
+		err = z.Close()
+		if err != nil {
+			t.Fatalf("Unexpected error compressing %s: %v", f.name, err)
+		}
+		data := buf.Bytes()
+		files[f.name] = data
+		descs = append(descs, dataFile{
+			url:    f.name,
+			length: int64(len(data)),
+			md5:    fmt.Sprintf("%x", md5.Sum(data)),
+			sha256: fmt.Sprintf("%x", sha256.Sum256(data)),
+		})
+	}
+	srv.Start()
+	t.Cleanup(srv.Close)
+	for i := range descs {
+		descs[i].url = srv.URL + descs[i].url
+	}
+	orig := mnist
+	mnist = descs
+	t.Cleanup(func() { mnist = orig })
+	return &requests
+}
+
+// subset returns a Set holding the first n samples of s.
+func subset(s Set, n int) Set {
+	stride := s.Rows() * s.Cols()
+	return Set{
+		count:  int32(n),
+		rows:   s.rows,
+		cols:   s.cols,
+		matrix: s.matrix[:n*stride],
+		labels: s.labels[:n],
+	}
+}
+
+func testData(n int) []byte {
+	data := make([]byte, n)
+	rand.New(rand.NewSource(1)).Read(data)
+	return data
+}
+
+func TestProgressFunc(t *testing.T) {
+	data := testData(1<<20 + 123)
+	srv, f := testServer(t, data)
+
+	var calls []int64
+	cfg := newConfig([]Option{
+		WithHTTPClient(srv.Client()),
+		WithProgressChunkSize(1 << 16),
+		WithProgressFunc(func(filename string, downloaded, total int64) {
+			if filename != "data.gz" {
+				t.Errorf("Unexpected file name: got: %q want: %q", filename, "data.gz")
+			}
+			if total != f.length {
+				t.Errorf("Unexpected total: got: %d want: %d", total, f.length)
+			}
+			calls = append(calls, downloaded)
+		}),
+	})
+	path, err := fetch(cfg, f, t.TempDir())
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if len(calls) < 2 {
+		t.Fatalf("Unexpected number of progress calls: got: %d want: >1", len(calls))
+	}
+	for i := 1; i < len(calls); i++ {
+		if calls[i] <= calls[i-1] {
+			t.Errorf("Progress not monotonically increasing: %v", calls)
+			break
+		}
+	}
+	if last := calls[len(calls)-1]; last != f.length {
+		t.Errorf("Unexpected final progress: got: %d want: %d", last, f.length)
+	}
+
+	got, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatalf("Unexpected error reading downloaded file: %v", err)
+	}
+	if !bytes.Equal(got, data) {
+		t.Error("Downloaded data does not match served data")
+	}
+}
+
+// redirectTransport sends all requests to the host of target.
+type redirectTransport struct {
+	target *url.URL
+	hosts  []string
+}
+
+func (t *redirectTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	t.hosts = append(t.hosts, req.URL.Host)
+	req = req.Clone(req.Context())
+	req.URL.Scheme = t.target.Scheme
+	req.URL.Host = t.target.Host
+	return http.DefaultTransport.RoundTrip(req)
+}
+
+func TestHTTPClient(t *testing.T) {
+	data := testData(4096)
+	var requested []string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requested = append(requested, r.URL.Path)
+		w.Write(data)
+	}))
+	defer srv.Close()
+	target, err := url.Parse(srv.URL)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	transport := &redirectTransport{target: target}
+
+	f := dataFile{
+		url:    "http://yann.lecun.com/exdb/mnist/data.gz",
+		length: int64(len(data)),
+		md5:    fmt.Sprintf("%x", md5.Sum(data)),
+	}
+	cfg := newConfig([]Option{WithHTTPClient(&http.Client{Transport: transport})})
+	_, err = fetch(cfg, f, t.TempDir())
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if len(transport.hosts) != 1 || transport.hosts[0] != "yann.lecun.com" {
+		t.Errorf("Unexpected requested hosts: got: %q want: %q", transport.hosts, []string{"yann.lecun.com"})
+	}
+	if len(requested) != 1 || requested[0] != "/exdb/mnist/data.gz" {
+		t.Errorf("Unexpected requests to test server: got: %q want: %q", requested, []string{"/exdb/mnist/data.gz"})
+	}
+}
+
+func TestMirrors(t *testing.T) {
+	data := testData(4096)
+	var failed int
+	broken := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		failed++
+		http.Error(w, "unavailable", http.StatusServiceUnavailable)
+	}))
+	defer broken.Close()
+	var served []string
+	mirror := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		served = append(served, r.URL.Path)
+		w.Write(data)
+	}))
+	defer mirror.Close()
+
+	f := dataFile{
+		url:    broken.URL + "/exdb/mnist/data.gz",
+		length: int64(len(data)),
+		md5:    fmt.Sprintf("%x", md5.Sum(data)),
+	}
+	cfg := newConfig([]Option{WithMirrors(broken.URL+"/mirror", mirror.URL+"/mirror")})
+	path, err := fetch(cfg, f, t.TempDir())
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if failed != 2 {
+		t.Errorf("Unexpected number of requests to failing server: got: %d want: 2", failed)
+	}
+	if len(served) != 1 || served[0] != "/mirror/data.gz" {
+		t.Errorf("Unexpected requests to mirror: got: %q want: %q", served, []string{"/mirror/data.gz"})
+	}
+	got, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatalf("Unexpected error reading downloaded file: %v", err)
+	}
+	if !bytes.Equal(got, data) {
+		t.Error("Downloaded data does not match served data")
+	}
+
+	cfg = newConfig([]Option{WithMirrors(broken.URL)})
+	_, err = fetch(cfg, f, t.TempDir())
+	if err == nil {
+		t.Error("Expected error when all mirrors fail")
+	}
+}
+
+func TestLoadMirrors(t *testing.T) {
+	defer func(train, test Set) { Train, Test = train, test }(Train, Test)
+	serveTestData(t, subset(Train, 200), subset(Test, 100))
+
+	var failed atomic.Int64
+	broken := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		failed.Add(1)
+		http.Error(w, "unavailable", http.StatusServiceUnavailable)
+	}))
+	defer broken.Close()
+
+	// Point the primary locations at the failing server and
+	// serve the data files from the mirror.
+	u, err := url.Parse(mnist[0].url)
+	if err != nil {
+		t.Fatalf("Unexpected error parsing test server URL: %v", err)
+	}
+	mirror := u.Scheme + "://" + u.Host
+	descs := append([]dataFile(nil), mnist...)
+	for i := range descs {
+		descs[i].url = broken.URL + strings.TrimPrefix(descs[i].url, mirror)
+	}
+	mnist = descs
+
+	err = Load(WithDataDir(t.TempDir()), WithMirrors(mirror))
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if Train.Len() != 200 || Test.Len() != 100 {
+		t.Errorf("Unexpected loaded lengths: got: %d %d want: 200 100", Train.Len(), Test.Len())
+	}
+	if failed.Load() == 0 {
+		t.Error("Expected requests to the primary location")
+	}
+}
+
+func TestOfflineMode(t *testing.T) {
+	data := testData(4096)
+	var requests int
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		w.Write(data)
+	}))
+	defer srv.Close()
+	f := dataFile{
+		url:    srv.URL + "/data.gz",
+		length: int64(len(data)),
+		md5:    fmt.Sprintf("%x", md5.Sum(data)),
+	}
+
+	dir := t.TempDir()
+	cfg := newConfig([]Option{OfflineMode()})
+	_, err := fetch(cfg, f, dir)
+	if err == nil {
+		t.Fatal("Expected error for missing file in offline mode")
+	}
+	if !strings.Contains(err.Error(), "offline") {
+		t.Errorf("Unexpected error message: %q does not mention offline", err)
+	}
+	if requests != 0 {
+		t.Errorf("Unexpected requests in offline mode: got: %d want: 0", requests)
+	}
+
+	// A valid local file is used without network access.
+	err = os.WriteFile(filepath.Join(dir, "data.gz"), data, 0o644)
+	if err != nil {
+		t.Fatalf("Unexpected error writing file: %v", err)
+	}
+	_, err = fetch(cfg, f, dir)
+	if err != nil {
+		t.Errorf("Unexpected error for valid file in offline mode: %v", err)
+	}
+	if requests != 0 {
+		t.Errorf("Unexpected requests in offline mode: got: %d want: 0", requests)
+	}
+}
+
+func TestNewOfflineMode(t *testing.T) {
+	requests := serveTestData(t, subset(Train, 200), subset(Test, 100))
+
+	dir := t.TempDir()
+	_, _, err := New(OfflineMode(), WithDataDir(dir))
+	if err == nil {
+		t.Fatal("Expected error for empty data directory in offline mode")
+	}
+	if n := requests.Load(); n != 0 {
+		t.Errorf("Unexpected requests in offline mode: got: %d want: 0", n)
+	}
+
+	_, _, err = New(WithDataDir(dir))
+	if err != nil {
+		t.Fatalf("Unexpected error populating data directory: %v", err)
+	}
+	fetched := requests.Load()
+
+	train, test, err := New(OfflineMode(), WithDataDir(dir))
+	if err != nil {
+		t.Fatalf("Unexpected error for populated data directory in offline mode: %v", err)
+	}
+	if train.Len() != 200 || test.Len() != 100 {
+		t.Errorf("Unexpected lengths: got: %d %d want: 200 100", train.Len(), test.Len())
+	}
+	if n := requests.Load(); n != fetched {
+		t.Errorf("Unexpected requests in offline mode: got: %d want: 0", n-fetched)
+	}
+}
+
+func TestVerifyOnLoad(t *testing.T) {
+	data := testData(4096)
+	srv, f := testServer(t, data)
+
+	corrupt := append([]byte(nil), data...)
+	corrupt[100] ^= 0xff
+
+	for _, test := range []struct {
+		opts    []Option
+		wantErr bool
+	}{
+		{opts: []Option{WithHTTPClient(srv.Client())}, wantErr: false},
+		{opts: []Option{WithHTTPClient(srv.Client()), VerifyOnLoad()}, wantErr: true},
+	} {
+		dir := t.TempDir()
+		path := filepath.Join(dir, "data.gz")
+		err := os.WriteFile(path, corrupt, 0o644)
+		if err != nil {
+			t.Fatalf("Unexpected error writing file: %v", err)
+		}
+		_, err = fetch(newConfig(test.opts), f, dir)
+		if (err != nil) != test.wantErr {
+			t.Errorf("Unexpected error status: got: %v want error: %t", err, test.wantErr)
+		}
+		if test.wantErr {
+			continue
+		}
+		got, err := os.ReadFile(path)
+		if err != nil {
+			t.Fatalf("Unexpected error reading file: %v", err)
+		}
+		if !bytes.Equal(got, data) {
+			t.Error("Corrupt file not replaced")
+		}
+	}
+}
+
+func TestVerify(t *testing.T) {
+	dir := t.TempDir()
+	var files []dataFile
+	for _, name := range []string{"a.gz", "b.gz", "c.gz"} {
+		data := testData(4096)
+		err := os.WriteFile(filepath.Join(dir, name), data, 0o644)
+		if err != nil {
+			t.Fatalf("Unexpected error writing file: %v", err)
+		}
+		files = append(files, dataFile{
+			url:    "http://example.com/" + name,
+			length: int64(len(data)),
+			md5:    fmt.Sprintf("%x", md5.Sum(data)),
+			sha256: fmt.Sprintf("%x", sha256.Sum256(data)),
+		})
+	}
+	err := verifyFiles(dir, files)
+	if err != nil {
+		t.Fatalf("Unexpected error for valid files: %v", err)
+	}
+
+	path := filepath.Join(dir, "b.gz")
+	data, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatalf("Unexpected error reading file: %v", err)
+	}
+	data[10] ^= 0x01
+	err = os.WriteFile(path, data, 0o644)
+	if err != nil {
+		t.Fatalf("Unexpected error writing file: %v", err)
+	}
+	err = verifyFiles(dir, files)
+	if err == nil {
+		t.Fatal("Expected error for corrupted file")
+	}
+	if !strings.Contains(err.Error(), "b.gz") {
+		t.Errorf("Error does not mention corrupted file: %v", err)
+	}
+	for _, name := range []string{"a.gz", "c.gz"} {
+		if strings.Contains(err.Error(), name) {
+			t.Errorf("Error mentions valid file %s: %v", name, err)
+		}
+	}
+}
+
+func TestVerifyDataDir(t *testing.T) {
+	serveTestData(t, subset(Train, 200), subset(Test, 100))
+
+	err := Verify(WithDataDir(t.TempDir()))
+	if err == nil {
+		t.Error("Expected error for empty data directory")
+	}
+
+	dir := t.TempDir()
+	_, _, err = New(WithDataDir(dir))
+	if err != nil {
+		t.Fatalf("Unexpected error populating data directory: %v", err)
+	}
+	err = Verify(WithDataDir(dir))
+	if err != nil {
+		t.Errorf("Unexpected error for valid data directory: %v", err)
+	}
+	var local []string
+	for _, name := range []string{"train-images.gz", "train-labels.gz", "test-images.gz", "test-labels.gz"} {
+		local = append(local, filepath.Join(dir, name))
+	}
+	err = Verify(WithLocalFiles(local[0], local[1], local[2], local[3]))
+	if err != nil {
+		t.Errorf("Unexpected error for valid local files: %v", err)
+	}
+
+	data, err := os.ReadFile(local[1])
+	if err != nil {
+		t.Fatalf("Unexpected error reading file: %v", err)
+	}
+	data[10] ^= 0x01
+	err = os.WriteFile(local[1], data, 0o644)
+	if err != nil {
+		t.Fatalf("Unexpected error writing file: %v", err)
+	}
+	for _, opt := range []Option{
+		WithDataDir(dir),
+		WithLocalFiles(local[0], local[1], local[2], local[3]),
+	} {
+		err = Verify(opt)
+		if err == nil {
+			t.Fatal("Expected error for corrupted file")
+		}
+		if !strings.Contains(err.Error(), "train-labels.gz") {
+			t.Errorf("Error does not mention corrupted file: %v", err)
+		}
+	}
+}
+
+func TestSetHandler(t *testing.T) {
+	defer func(l *slog.Logger) { logger = l }(logger)
+
+	data := testData(4096)
+	srv, f := testServer(t, data)
+	dir := t.TempDir()
+
+	var sb strings.Builder
+	SetHandler(slog.NewTextHandler(&sb, nil))
+	_, err := fetch(newConfig([]Option{WithHTTPClient(srv.Client())}), f, dir)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	_, err = fetch(newConfig([]Option{OfflineMode()}), dataFile{url: srv.URL + "/missing.gz"}, dir)
+	if err == nil {
+		t.Fatal("Expected error for missing file in offline mode")
+	}
+	out := sb.String()
+	for _, want := range []string{
+		"level=INFO",
+		"level=ERROR",
+		"file=data.gz",
+		"file=missing.gz",
+		"status=downloading",
+		"status=downloaded",
+		`status="unavailable offline"`,
+	} {
+		if !strings.Contains(out, want) {
+			t.Errorf("Log output missing %q:\n%s", want, out)
+		}
+	}
+
+	sb.Reset()
+	SetLegacyLogger(log.New(&sb, "prefix: ", 0))
+	_, err = fetch(newConfig(nil), f, dir)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	out = sb.String()
+	if !strings.HasPrefix(out, "prefix: ") || !strings.Contains(out, "status=ok") || strings.Contains(out, "time=") {
+		t.Errorf("Unexpected legacy log output: %q", out)
+	}
+
+	sb.Reset()
+	SetHandler(nil)
+	_, err = fetch(newConfig(nil), f, dir)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if sb.Len() != 0 {
+		t.Errorf("Unexpected log output with logging disabled: %q", sb.String())
+	}
+}
+
+func TestDataDir(t *testing.T) {
+	defer func(train, test Set) { Train, Test = train, test }(Train, Test)
+	serveTestData(t, subset(Train, 200), subset(Test, 100))
+
+	for _, test := range []struct {
+		name string
+		opts func(dir string) []Option
+	}{
+		{
+			name: "WithDataDir",
+			opts: func(dir string) []Option { return []Option{WithDataDir(dir)} },
+		},
+		{
+			name: "WithEnvDir",
+			opts: func(dir string) []Option {
+				t.Setenv("MNIST_TEST_DATA_DIR", dir)
+				return []Option{WithEnvDir("MNIST_TEST_DATA_DIR")}
+			},
+		},
+	} {
+		dir := filepath.Join(t.TempDir(), "data")
+		err := Load(test.opts(dir)...)
+		if err != nil {
+			t.Fatalf("Unexpected error for %s: %v", test.name, err)
+		}
+		for _, name := range []string{"train-images.gz", "train-labels.gz", "test-images.gz", "test-labels.gz"} {
+			_, err := os.Stat(filepath.Join(dir, name))
+			if err != nil {
+				t.Errorf("Missing data file for %s: %v", test.name, err)
+			}
+		}
+		if Train.Len() != 200 || Test.Len() != 100 {
+			t.Errorf("Unexpected loaded lengths for %s: got: %d %d want: 200 100", test.name, Train.Len(), Test.Len())
+		}
+	}
+}
+
+func TestNew(t *testing.T) {
+	wantTrain, wantTest := subset(Train, 200), subset(Test, 100)
+	serveTestData(t, wantTrain, wantTest)
+
+	// New must not depend on Train and Test having been loaded.
+	defer func(train, test Set) { Train, Test = train, test }(Train, Test)
+	Train, Test = Set{}, Set{}
+
+	var wg sync.WaitGroup
+	for i := 0; i < 2; i++ {
+		dir := t.TempDir()
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			train, test, err := New(WithDataDir(dir))
+			if err != nil {
+				t.Errorf("Unexpected error: %v", err)
+				return
+			}
+			if train.Len() != 200 || test.Len() != 100 {
+				t.Errorf("Unexpected lengths: got: %d %d want: 200 100", train.Len(), test.Len())
+			}
+			if !bytes.Equal(train.matrix, wantTrain.matrix) {
+				t.Error("Unexpected training image data")
+			}
+			if !bytes.Equal(test.labels, wantTest.labels) {
+				t.Error("Unexpected test label data")
+			}
+		}()
+	}
+	wg.Wait()
+
+	if Train.Len() != 0 || Test.Len() != 0 {
+		t.Errorf("Package data sets altered by New: got: %d %d want: 0 0", Train.Len(), Test.Len())
+	}
+}
+
+func TestFetchLock(t *testing.T) {
+	requests := serveTestData(t, subset(Train, 200), subset(Test, 100))
+	var files []string
+	for _, f := range mnist {
+		files = append(files, fmt.Sprintf("%s %d %s %s", f.url, f.length, f.md5, f.sha256))
+	}
+	dir := t.TempDir()
+
+	var wg sync.WaitGroup
+	for i := 0; i < 2; i++ {
+		cmd := exec.Command(os.Args[0], "-test.run=^TestFetchLockHelper$")
+		cmd.Env = append(os.Environ(),
+			"MNIST_LOCK_TEST_DIR="+dir,
+			"MNIST_LOCK_TEST_FILES="+strings.Join(files, "\n"),
+		)
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			out, err := cmd.CombinedOutput()
+			if err != nil {
+				t.Errorf("Unexpected error from loading process: %v\n%s", err, out)
+			}
+		}()
+	}
+	wg.Wait()
+
+	err := verifyFiles(dir, mnist)
+	if err != nil {
+		t.Errorf("Unexpected error verifying downloaded files: %v", err)
+	}
+	if n := requests.Load(); n != int64(len(mnist)) {
+		t.Errorf("Unexpected number of downloads: got: %d want: %d", n, len(mnist))
+	}
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		t.Fatalf("Unexpected error reading data directory: %v", err)
+	}
+	// With the mmap build tag, image files are also held decompressed
+	// alongside the downloaded files.
+	want := []string{lockName, "test-images.gz", "test-labels.gz", "train-images.gz", "train-labels.gz"}
+	var names []string
+	for _, e := range entries {
+		names = append(names, e.Name())
+		if !slices.Contains(want, e.Name()) && !slices.Contains(want, e.Name()+".gz") {
+			t.Errorf("Unexpected file in data directory: %q", e.Name())
+		}
+	}
+	for _, name := range want {
+		if !slices.Contains(names, name) {
+			t.Errorf("Missing file in data directory: %q", name)
+		}
+	}
+}
+
+// TestFetchLockHelper is run as a separate process by TestFetchLock.
+func TestFetchLockHelper(t *testing.T) {
+	dir := os.Getenv("MNIST_LOCK_TEST_DIR")
+	if dir == "" {
+		t.Skip("helper process for TestFetchLock")
+	}
+	var descs []dataFile
+	for _, line := range strings.Split(os.Getenv("MNIST_LOCK_TEST_FILES"), "\n") {
+		f := strings.Fields(line)
+		if len(f) != 4 {
+			t.Fatalf("Invalid file description: %q", line)
+		}
+		length, err := strconv.ParseInt(f[1], 10, 64)
+		if err != nil {
+			t.Fatalf("Invalid file length: %v", err)
+		}
+		descs = append(descs, dataFile{url: f[0], length: length, md5: f[2], sha256: f[3]})
+	}
+	orig := mnist
+	mnist = descs
+	defer func() { mnist = orig }()
+
+	train, test, err := New(WithDataDir(dir))
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if train.Len() != 200 || test.Len() != 100 {
+		t.Errorf("Unexpected lengths: got: %d %d want: 200 100", train.Len(), test.Len())
+	}
+}
+
+// errTransport is an http.RoundTripper that always fails.
+type errTransport struct{}
+
+func (errTransport) RoundTrip(*http.Request) (*http.Response, error) {
+	return nil, errors.New("network access attempted")
+}
+
+func TestLocalFiles(t *testing.T) {
+	noNet := WithHTTPClient(&http.Client{Transport: errTransport{}})
+
+	trainDir, testDir := t.TempDir(), t.TempDir()
+	trainImages, trainLabels := writeGzipIDX(t, subset(Train, 200), trainDir)
+	testImages, testLabels := writeGzipIDX(t, subset(Test, 100), testDir)
+	train, test, err := New(noNet, WithLocalFiles(trainImages, trainLabels, testImages, testLabels))
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if train.Len() != 200 || test.Len() != 100 {
+		t.Errorf("Unexpected lengths: got: %d %d want: 200 100", train.Len(), test.Len())
+	}
+
+	// File URLs are read directly.
+	data := testData(4096)
+	src := filepath.Join(t.TempDir(), "data.gz")
+	err = os.WriteFile(src, data, 0o644)
+	if err != nil {
+		t.Fatalf("Unexpected error writing file: %v", err)
+	}
+	f := dataFile{
+		url:    (&url.URL{Scheme: "file", Path: "/" + strings.TrimPrefix(filepath.ToSlash(src), "/")}).String(),
+		length: int64(len(data)),
+		md5:    fmt.Sprintf("%x", md5.Sum(data)),
+	}
+	path, err := fetch(newConfig([]Option{noNet}), f, t.TempDir())
+	if err != nil {
+		t.Fatalf("Unexpected error for file URL: %v", err)
+	}
+	got, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatalf("Unexpected error reading file: %v", err)
+	}
+	if !bytes.Equal(got, data) {
+		t.Error("Copied data does not match source data")
+	}
+}
+
+func TestFilePath(t *testing.T) {
+	for _, test := range []struct {
+		url     string
+		want    string
+		windows string
+	}{
+		{url: "file:///data/x.gz", want: "/data/x.gz", windows: `\data\x.gz`},
+		{url: "file:///C:/data/x.gz", want: "/C:/data/x.gz", windows: `C:\data\x.gz`},
+		{url: "file:///c:/x.gz", want: "/c:/x.gz", windows: `c:\x.gz`},
+		{url: "file:///C:", want: "/C:", windows: `C:`},
+		{url: "file:///", want: "/", windows: `\`},
+	} {
+		u, err := url.Parse(test.url)
+		if err != nil {
+			t.Fatalf("Unexpected error parsing %q: %v", test.url, err)
+		}
+		want := test.want
+		if runtime.GOOS == "windows" {
+			want = test.windows
+		}
+		if got := filePath(u); got != want {
+			t.Errorf("Unexpected path for %q: got: %q want: %q", test.url, got, want)
+		}
+	}
+}
+
+func TestLoadFromHTTP(t *testing.T) {
+	want := subset(Test, 100)
+	serveTestData(t, subset(Train, 10), want)
+	imageURL, labelURL := mnist[2].url, mnist[3].url
+
+	var progress []string
+	dir := t.TempDir()
+	got, err := LoadFromHTTP(imageURL, labelURL, WithDataDir(dir),
+		WithProgressFunc(func(filename string, _, _ int64) {
+			progress = append(progress, filename)
+		}),
+	)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if got.Len() != want.Len() || got.Rows() != want.Rows() || got.Cols() != want.Cols() {
+		t.Fatalf("Unexpected shape: got: %d×%d×%d want: %d×%d×%d",
+			got.Len(), got.Rows(), got.Cols(), want.Len(), want.Rows(), want.Cols())
+	}
+	if !bytes.Equal(got.matrix, want.matrix) || !bytes.Equal(got.labels, want.labels) {
+		t.Error("Unexpected data")
+	}
+	if got.Metadata().Source != imageURL {
+		t.Errorf("Unexpected source: got: %q want: %q", got.Metadata().Source, imageURL)
+	}
+	if len(progress) == 0 {
+		t.Error("Expected progress reports")
+	}
+	if Test.Len() == want.Len() {
+		t.Error("Test altered by LoadFromHTTP")
+	}
+
+	// Files kept in the data directory are reused.
+	noNet := WithHTTPClient(&http.Client{Transport: errTransport{}})
+	_, err = LoadFromHTTP(imageURL, labelURL, WithDataDir(dir), noNet, OfflineMode())
+	if err != nil {
+		t.Errorf("Unexpected error loading cached files: %v", err)
+	}
+
+	// Without a data directory nothing is cached.
+	_, err = LoadFromHTTP(imageURL, labelURL, noNet)
+	if err == nil {
+		t.Error("Expected error without network access")
+	}
+
+	_, err = LoadFromHTTP(imageURL, imageURL+"/missing")
+	if err == nil {
+		t.Error("Expected error for missing label file")
+	}
+}
+
+func TestLoadFromHTTPPartial(t *testing.T) {
+	data := subset(Test, 100)
+	var images bytes.Buffer
+	z := gzip.NewWriter(&images)
+	err := data.encodeImages(z)
+	if err != nil {
+		t.Fatalf("Unexpected error encoding images: %v", err)
+	}
+	z.Close()
+	var truncate atomic.Bool
+	truncate.Store(true)
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/images.gz" {
+			http.NotFound(w, r)
+			return
+		}
+		if truncate.Load() {
+			// Claim the full length but send only half the data
+			// so that the client sees an unexpected EOF.
+			w.Header().Set("Content-Length", strconv.Itoa(images.Len()))
+			w.Write(images.Bytes()[:images.Len()/2])
+			return
+		}
+		w.Write(images.Bytes())
+	}))
+	t.Cleanup(srv.Close)
+
+	dir := t.TempDir()
+	_, err = LoadFromHTTP(srv.URL+"/images.gz", srv.URL+"/labels.gz", WithDataDir(dir))
+	if err == nil {
+		t.Fatal("Expected error for truncated download")
+	}
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		t.Fatalf("Unexpected error reading data directory: %v", err)
+	}
+	for _, e := range entries {
+		if strings.HasPrefix(e.Name(), "images.gz") {
+			t.Errorf("Unexpected file left after failed download: %s", e.Name())
+		}
+	}
+
+	// A later download is not short-circuited by a partial file.
+	truncate.Store(false)
+	_, err = LoadFromHTTP(srv.URL+"/images.gz", srv.URL+"/labels.gz", WithDataDir(dir))
+	if err == nil {
+		t.Fatal("Expected error for missing label file")
+	}
+	got, err := os.ReadFile(filepath.Join(dir, "images.gz"))
+	if err != nil {
+		t.Fatalf("Unexpected error reading downloaded file: %v", err)
+	}
+	if !bytes.Equal(got, images.Bytes()) {
+		t.Error("Unexpected downloaded image file contents")
+	}
+}
@@ -0,0 +1,14 @@
+// Copyright ©2026 The bíogo.nn Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+//go:build !unix && !windows
+
+package mnist
+
+// lockFile is a no-op since file locking is not available on this
+// platform. Concurrent downloads by separate processes are not
+// prevented.
+func lockFile(path string) (unlock func() error, err error) {
+	return func() error { return nil }, nil
+}
@@ -0,0 +1,76 @@
+// Copyright ©2026 The bíogo.nn Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mnist
+
+import (
+	"bytes"
+	"log"
+	"log/slog"
+	"os"
+)
+
+// logger is used to log MNIST data retrieval. If logger is nil,
+// no logging is performed.
+var logger = slog.New(legacyHandler(log.New(os.Stderr, "mnist: ", log.LstdFlags)))
+
+// SetHandler sets the handler used to log MNIST data retrieval. Normal events
+// are logged at slog.LevelInfo and failures at slog.LevelError, with the
+// attributes "file" and "status" describing the data file and its state.
+// If h is nil, logging is disabled. SetHandler must not be called concurrently
+// with Load.
+func SetHandler(h slog.Handler) {
+	if h == nil {
+		logger = nil
+		return
+	}
+	logger = slog.New(h)
+}
+
+// SetLegacyLogger sets l to be used to log MNIST data retrieval. Records are
+// written to l in slog text format without a time attribute, since l adds its
+// own time stamp according to its flags. If l is nil, logging is disabled.
+func SetLegacyLogger(l *log.Logger) {
+	if l == nil {
+		SetHandler(nil)
+		return
+	}
+	SetHandler(legacyHandler(l))
+}
+
+func legacyHandler(l *log.Logger) slog.Handler {
+	return slog.NewTextHandler(logWriter{l}, &slog.HandlerOptions{
+		ReplaceAttr: func(groups []string, a slog.Attr) slog.Attr {
+			if len(groups) == 0 && a.Key == slog.TimeKey {
+				return slog.Attr{}
+			}
+			return a
+		},
+	})
+}
+
+// logWriter is an io.Writer that writes each record to a *log.Logger.
+type logWriter struct {
+	l *log.Logger
+}
+
+func (w logWriter) Write(b []byte) (int, error) {
+	err := w.l.Output(2, string(bytes.TrimSuffix(b, []byte("\n"))))
+	if err != nil {
+		return 0, err
+	}
+	return len(b), nil
+}
+
+func logInfo(msg string, args ...any) {
+	if logger != nil {
+		logger.Info(msg, args...)
+	}
+}
+
+func logError(msg string, args ...any) {
+	if logger != nil {
+		logger.Error(msg, args...)
+	}
+}
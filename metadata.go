@@ -0,0 +1,79 @@
+// Copyright ©2026 The bíogo.nn Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mnist
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"os"
+	"time"
+)
+
+// Metadata describes the provenance of a data set.
+type Metadata struct {
+	// Source is the location the data was read from,
+	// usually the URL of the image data file.
+	Source string `json:"source"`
+
+	// LoadedAt is the time the data was read.
+	LoadedAt time.Time `json:"loaded_at"`
+
+	// Rows, Cols and Count are the image dimensions
+	// and number of images of the data set.
+	Rows  int `json:"rows"`
+	Cols  int `json:"cols"`
+	Count int `json:"count"`
+
+	// SHA256 is the hex encoded value of Hash for
+	// the data set.
+	SHA256 string `json:"sha256"`
+}
+
+// Metadata returns the provenance metadata of the data set. For sets returned
+// by Load and New this is populated when the data is read. If the set's
+// metadata does not include a SHA256 value, the Rows, Cols, Count and SHA256
+// fields are computed from the current content of the set.
+func (s *Set) Metadata() Metadata {
+	m := s.meta
+	if m.SHA256 == "" {
+		m.Rows, m.Cols, m.Count = s.Rows(), s.Cols(), s.Len()
+		h := s.Hash()
+		m.SHA256 = hex.EncodeToString(h[:])
+	}
+	return m
+}
+
+// WithMetadata returns a copy of the data set with the metadata m attached.
+// The image and label data of the returned Set are shared with s.
+func (s *Set) WithMetadata(m Metadata) Set {
+	c := *s
+	c.meta = m
+	return c
+}
+
+// WriteMetadataSidecar writes the metadata returned by s.Metadata to the file
+// at path as JSON, for storage alongside the data files of the set.
+func (s *Set) WriteMetadataSidecar(path string) error {
+	b, err := json.MarshalIndent(s.Metadata(), "", "\t")
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(path, append(b, '\n'), 0o644)
+}
+
+// ReadMetadataSidecar reads JSON metadata written by WriteMetadataSidecar
+// from the file at path.
+func ReadMetadataSidecar(path string) (Metadata, error) {
+	b, err := os.ReadFile(path)
+	if err != nil {
+		return Metadata{}, err
+	}
+	var m Metadata
+	err = json.Unmarshal(b, &m)
+	if err != nil {
+		return Metadata{}, err
+	}
+	return m, nil
+}
@@ -0,0 +1,128 @@
+// Copyright ©2026 The bíogo.nn Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mnist
+
+import (
+	"encoding/hex"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func TestMetadata(t *testing.T) {
+	for _, s := range []struct {
+		name string
+		set  *Set
+	}{
+		{name: "Train", set: &Train},
+		{name: "Test", set: &Test},
+	} {
+		m := s.set.Metadata()
+		h := s.set.Hash()
+		if want := hex.EncodeToString(h[:]); m.SHA256 != want {
+			t.Errorf("Unexpected SHA256 for %q: got: %s want: %s", s.name, m.SHA256, want)
+		}
+		if m.Rows != s.set.Rows() || m.Cols != s.set.Cols() || m.Count != s.set.Len() {
+			t.Errorf("Unexpected dimensions for %q: got: %d×%d×%d want: %d×%d×%d",
+				s.name, m.Count, m.Rows, m.Cols, s.set.Len(), s.set.Rows(), s.set.Cols())
+		}
+	}
+
+	custom := Metadata{
+		Source:   "https://example.com/digits",
+		LoadedAt: time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC),
+		Rows:     28, Cols: 28, Count: 100,
+		SHA256: "0123",
+	}
+	s := subset(Test, 100)
+	d := s.WithMetadata(custom)
+	if got := d.Metadata(); got != custom {
+		t.Errorf("Unexpected attached metadata: got: %+v want: %+v", got, custom)
+	}
+	if got := s.Metadata(); got == custom {
+		t.Error("Metadata attached to original set")
+	}
+}
+
+func TestNewMetadata(t *testing.T) {
+	serveTestData(t, subset(Train, 200), subset(Test, 100))
+	before := time.Now()
+	train, test, err := New(WithDataDir(t.TempDir()))
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	for _, s := range []struct {
+		name string
+		set  Set
+		src  string
+	}{
+		{name: "train", set: train, src: mnist[0].url},
+		{name: "test", set: test, src: mnist[2].url},
+	} {
+		m := s.set.Metadata()
+		if m.Source != s.src {
+			t.Errorf("Unexpected source for %s: got: %q want: %q", s.name, m.Source, s.src)
+		}
+		if m.LoadedAt.Before(before) || m.LoadedAt.After(time.Now()) {
+			t.Errorf("Unexpected load time for %s: %v", s.name, m.LoadedAt)
+		}
+		h := s.set.Hash()
+		if want := hex.EncodeToString(h[:]); m.SHA256 != want {
+			t.Errorf("Unexpected SHA256 for %s: got: %s want: %s", s.name, m.SHA256, want)
+		}
+	}
+}
+
+func TestMetadataSidecar(t *testing.T) {
+	dir := t.TempDir()
+
+	want := Metadata{
+		Source:   "https://example.com/digits",
+		LoadedAt: time.Date(2026, 1, 2, 3, 4, 5, 6, time.UTC),
+		Rows:     28, Cols: 28, Count: 100,
+		SHA256: "0123456789abcdef",
+	}
+	s := subset(Test, 100)
+	d := s.WithMetadata(want)
+	path := filepath.Join(dir, "custom.json")
+	err := d.WriteMetadataSidecar(path)
+	if err != nil {
+		t.Fatalf("Unexpected error writing sidecar: %v", err)
+	}
+	got, err := ReadMetadataSidecar(path)
+	if err != nil {
+		t.Fatalf("Unexpected error reading sidecar: %v", err)
+	}
+	if !got.LoadedAt.Equal(want.LoadedAt) {
+		t.Errorf("Unexpected load time: got: %v want: %v", got.LoadedAt, want.LoadedAt)
+	}
+	got.LoadedAt = want.LoadedAt
+	if got != want {
+		t.Errorf("Unexpected round trip metadata: got: %+v want: %+v", got, want)
+	}
+
+	// The checksum written for a set must match the set's content.
+	path = filepath.Join(dir, "test.json")
+	err = s.WriteMetadataSidecar(path)
+	if err != nil {
+		t.Fatalf("Unexpected error writing sidecar: %v", err)
+	}
+	got, err = ReadMetadataSidecar(path)
+	if err != nil {
+		t.Fatalf("Unexpected error reading sidecar: %v", err)
+	}
+	h := s.Hash()
+	if sum := hex.EncodeToString(h[:]); got.SHA256 != sum {
+		t.Errorf("Unexpected sidecar checksum: got: %s want: %s", got.SHA256, sum)
+	}
+	if got.Count != s.Len() || got.Rows != s.Rows() || got.Cols != s.Cols() {
+		t.Errorf("Unexpected sidecar dimensions: got: %d×%d×%d", got.Count, got.Rows, got.Cols)
+	}
+
+	_, err = ReadMetadataSidecar(filepath.Join(dir, "missing.json"))
+	if err == nil {
+		t.Error("Expected error reading missing sidecar")
+	}
+}
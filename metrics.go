@@ -0,0 +1,124 @@
+// Copyright ©2026 The bíogo.nn Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mnist
+
+import (
+	"errors"
+	"fmt"
+)
+
+// Confusion returns the confusion matrix for the given predictions of the labels
+// of the data set. Element [t][p] of the returned matrix holds the number of
+// samples with true label t that were predicted to have label p. The length of
+// predictions must equal s.Len().
+func (s *Set) Confusion(predictions []byte) ([10][10]int, error) {
+	var m [10][10]int
+	if len(predictions) != s.Len() {
+		return m, errors.New("mnist: mismatched number of predictions and labels")
+	}
+	for i, p := range predictions {
+		t := s.labels[i]
+		if t > 9 || p > 9 {
+			return m, fmt.Errorf("mnist: label out of range at %d: true=%d predicted=%d", i, t, p)
+		}
+		m[t][p]++
+	}
+	return m, nil
+}
+
+// Accuracy returns the fraction of predictions that match the corresponding
+// element of truth. The lengths of truth and predictions must be equal.
+func Accuracy(truth, predictions []byte) (float64, error) {
+	if len(truth) != len(predictions) {
+		return 0, errors.New("mnist: mismatched number of predictions and labels")
+	}
+	if len(truth) == 0 {
+		return 0, errors.New("mnist: no predictions")
+	}
+	var correct int
+	for i, t := range truth {
+		if predictions[i] == t {
+			correct++
+		}
+	}
+	return float64(correct) / float64(len(truth)), nil
+}
+
+// TopKAccuracy returns the fraction of samples where the true label is among
+// the k highest scoring classes. Each element of predictions holds the class
+// scores, for example softmax outputs, for the corresponding element of truth.
+// The lengths of truth and predictions must be equal.
+func TopKAccuracy(truth []byte, predictions [][]float32, k int) (float64, error) {
+	if len(truth) != len(predictions) {
+		return 0, errors.New("mnist: mismatched number of predictions and labels")
+	}
+	if len(truth) == 0 {
+		return 0, errors.New("mnist: no predictions")
+	}
+	if k < 1 {
+		return 0, errors.New("mnist: k must be positive")
+	}
+	var correct int
+	for i, t := range truth {
+		scores := predictions[i]
+		if int(t) >= len(scores) {
+			return 0, fmt.Errorf("mnist: label %d out of range of scores at %d", t, i)
+		}
+		// The true label is in the top k if fewer than k
+		// classes score strictly higher than it.
+		var higher int
+		for _, v := range scores {
+			if v > scores[t] {
+				higher++
+			}
+		}
+		if higher < k {
+			correct++
+		}
+	}
+	return float64(correct) / float64(len(truth)), nil
+}
+
+// PerClassAccuracy returns the fraction of samples of each label in the data set
+// that are correctly predicted. The length of predictions must equal s.Len().
+func (s *Set) PerClassAccuracy(predictions []byte) (map[byte]float64, error) {
+	if len(predictions) != s.Len() {
+		return nil, errors.New("mnist: mismatched number of predictions and labels")
+	}
+	counts := s.labelCounts()
+	var correct [256]int
+	for i, p := range predictions {
+		if s.labels[i] == p {
+			correct[p]++
+		}
+	}
+	acc := make(map[byte]float64)
+	for l, n := range counts {
+		if n == 0 {
+			continue
+		}
+		acc[byte(l)] = float64(correct[l]) / float64(n)
+	}
+	return acc, nil
+}
+
+// LabelAgreement returns the fraction of samples for which the label in s
+// matches the label at the same position in other. It returns zero if the
+// sets are empty. The lengths of the sets must be equal.
+func (s *Set) LabelAgreement(other Set) (float64, error) {
+	if s.Len() != other.Len() {
+		return 0, errors.New("mnist: mismatched set lengths")
+	}
+	if s.Len() == 0 {
+		return 0, nil
+	}
+	var agree int
+	for i, l := range s.labels {
+		if l == other.labels[i] {
+			agree++
+		}
+	}
+	return float64(agree) / float64(s.Len()), nil
+}
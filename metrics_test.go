@@ -0,0 +1,199 @@
+// Copyright ©2026 The bíogo.nn Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mnist
+
+import (
+	"math"
+	"testing"
+)
+
+func TestConfusion(t *testing.T) {
+	perfect := append([]byte(nil), Test.labels...)
+	m, err := Test.Confusion(perfect)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	counts := Test.labelCounts()
+	for i := range m {
+		for j, n := range m[i] {
+			want := 0
+			if i == j {
+				want = counts[i]
+			}
+			if n != want {
+				t.Errorf("Unexpected count for perfect predictions at [%d][%d]: got: %d want: %d", i, j, n, want)
+			}
+		}
+	}
+
+	// Swap predictions for classes 3 and 5.
+	swapped := append([]byte(nil), Test.labels...)
+	for i, l := range swapped {
+		switch l {
+		case 3:
+			swapped[i] = 5
+		case 5:
+			swapped[i] = 3
+		}
+	}
+	m, err = Test.Confusion(swapped)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	for i := range m {
+		for j, n := range m[i] {
+			want := 0
+			switch {
+			case i == 3 && j == 5, i == 5 && j == 3:
+				want = counts[i]
+			case i == j && i != 3 && i != 5:
+				want = counts[i]
+			}
+			if n != want {
+				t.Errorf("Unexpected count for swapped predictions at [%d][%d]: got: %d want: %d", i, j, n, want)
+			}
+		}
+	}
+
+	_, err = Test.Confusion(perfect[1:])
+	if err == nil {
+		t.Error("Expected error for mismatched prediction length")
+	}
+}
+
+func TestAccuracy(t *testing.T) {
+	truth := Test.labels
+	acc, err := Accuracy(truth, truth)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if acc != 1 {
+		t.Errorf("Unexpected accuracy for identical labels: got: %v want: 1", acc)
+	}
+
+	inverted := make([]byte, len(truth))
+	for i, l := range truth {
+		inverted[i] = 9 - l
+	}
+	acc, err = Accuracy(truth, inverted)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if acc != 0 {
+		t.Errorf("Unexpected accuracy for inverted labels: got: %v want: 0", acc)
+	}
+
+	_, err = Accuracy(truth, truth[1:])
+	if err == nil {
+		t.Error("Expected error for mismatched lengths")
+	}
+}
+
+func TestTopKAccuracy(t *testing.T) {
+	truth := Test.labels
+	perfect := make([][]float32, len(truth))
+	inverted := make([][]float32, len(truth))
+	for i, l := range truth {
+		perfect[i] = make([]float32, 10)
+		perfect[i][l] = 1
+		inverted[i] = make([]float32, 10)
+		for c := range inverted[i] {
+			inverted[i][c] = 1
+		}
+		inverted[i][l] = 0
+	}
+
+	for _, test := range []struct {
+		name        string
+		predictions [][]float32
+		k           int
+		want        float64
+	}{
+		{name: "perfect", predictions: perfect, k: 1, want: 1},
+		{name: "perfect", predictions: perfect, k: 5, want: 1},
+		{name: "inverted", predictions: inverted, k: 1, want: 0},
+		{name: "inverted", predictions: inverted, k: 9, want: 0},
+		{name: "inverted", predictions: inverted, k: 10, want: 1},
+	} {
+		acc, err := TopKAccuracy(truth, test.predictions, test.k)
+		if err != nil {
+			t.Errorf("Unexpected error for %s k=%d: %v", test.name, test.k, err)
+			continue
+		}
+		if acc != test.want {
+			t.Errorf("Unexpected accuracy for %s k=%d: got: %v want: %v", test.name, test.k, acc, test.want)
+		}
+	}
+}
+
+func TestPerClassAccuracy(t *testing.T) {
+	// Predict correctly for all samples with an even index.
+	predictions := make([]byte, Test.Len())
+	for i, l := range Test.labels {
+		if i%2 == 0 {
+			predictions[i] = l
+		} else {
+			predictions[i] = (l + 1) % 10
+		}
+	}
+	acc, err := Test.PerClassAccuracy(predictions)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if len(acc) != 10 {
+		t.Errorf("Unexpected number of classes: got: %d want: 10", len(acc))
+	}
+	overall, err := Accuracy(Test.labels, predictions)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	counts := Test.labelCounts()
+	var got float64
+	for c, a := range acc {
+		got += float64(counts[c]) * a / float64(Test.Len())
+	}
+	if math.Abs(got-overall) > 1e-12 {
+		t.Errorf("Unexpected weighted per-class accuracy: got: %v want: %v", got, overall)
+	}
+}
+
+func TestLabelAgreement(t *testing.T) {
+	got, err := Test.LabelAgreement(Test)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if got != 1 {
+		t.Errorf("Unexpected self agreement: got: %v want: 1", got)
+	}
+
+	shifted := Test
+	shifted.labels = make([]byte, Test.Len())
+	for i, l := range Test.labels {
+		shifted.labels[i] = (l + 1) % 10
+	}
+	got, err = Test.LabelAgreement(shifted)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if got != 0 {
+		t.Errorf("Unexpected agreement with shifted labels: got: %v want: 0", got)
+	}
+
+	for i := 0; i < Test.Len(); i += 4 {
+		shifted.labels[i] = Test.labels[i]
+	}
+	got, err = Test.LabelAgreement(shifted)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if got != 0.25 {
+		t.Errorf("Unexpected agreement with partially shifted labels: got: %v want: 0.25", got)
+	}
+
+	_, err = Test.LabelAgreement(subset(Test, 10))
+	if err == nil {
+		t.Error("Expected error for mismatched lengths")
+	}
+}
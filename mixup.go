@@ -0,0 +1,200 @@
+// Copyright ©2026 The bíogo.nn Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mnist
+
+import (
+	"errors"
+	"fmt"
+	"image"
+	"math"
+	"math/rand"
+)
+
+// numClasses is the number of MNIST digit classes.
+const numClasses = 10
+
+// A MixupSet contains a set of blended images with soft labels
+// produced by data augmentation.
+type MixupSet struct {
+	count      int
+	rows, cols int
+	images     []float32 // count*rows*cols
+	labels     []float64 // count*numClasses
+}
+
+// newMixupSet returns a MixupSet able to hold n images with the given dimensions.
+func newMixupSet(n, rows, cols int) MixupSet {
+	return MixupSet{
+		count:  n,
+		rows:   rows,
+		cols:   cols,
+		images: make([]float32, n*rows*cols),
+		labels: make([]float64, n*numClasses),
+	}
+}
+
+// Rows returns the number of pixel rows in the images of the data set.
+func (s *MixupSet) Rows() int { return s.rows }
+
+// Cols returns the number of pixel columns in the images of the data set.
+func (s *MixupSet) Cols() int { return s.cols }
+
+// Len returns the number of images in the data set.
+func (s *MixupSet) Len() int { return s.count }
+
+// ImageFloat32 returns the i'th image of the data set with pixel values in [0,1].
+func (s *MixupSet) ImageFloat32(i int) []float32 {
+	stride := s.rows * s.cols
+	return s.images[i*stride : (i+1)*stride]
+}
+
+// LabelVector returns the soft label of the i'th image of the data set. Element
+// c of the returned slice is the weight of class c, and the elements sum to 1.
+func (s *MixupSet) LabelVector(i int) []float64 {
+	return s.labels[i*numClasses : (i+1)*numClasses]
+}
+
+// Mixup returns a MixupSet holding a convex combination of each image of s with a
+// randomly chosen image of other, as described by Zhang et al. "mixup: Beyond
+// Empirical Risk Minimization" ICLR 2018. For each sample the mixing coefficient
+// λ is drawn from Beta(alpha, alpha) and the blended image is λ·image_s +
+// (1-λ)·image_other with the label weighted likewise.
+func (s *Set) Mixup(other Set, alpha float64, r *rand.Rand) (MixupSet, error) {
+	if alpha <= 0 {
+		return MixupSet{}, errors.New("mnist: alpha must be positive")
+	}
+	err := s.checkMixPartner(other)
+	if err != nil {
+		return MixupSet{}, err
+	}
+
+	m := newMixupSet(s.Len(), s.Rows(), s.Cols())
+	for i := 0; i < s.Len(); i++ {
+		lambda := betaRand(alpha, alpha, r)
+		j := r.Intn(other.Len())
+		li, a := s.Index(i)
+		lj, b := other.Index(j)
+		dst := m.ImageFloat32(i)
+		for k := range dst {
+			dst[k] = float32((lambda*float64(a[k]) + (1-lambda)*float64(b[k])) / 255)
+		}
+		lv := m.LabelVector(i)
+		lv[li] += lambda
+		lv[lj] += 1 - lambda
+	}
+	return m, nil
+}
+
+// CutMix returns a MixupSet where a random rectangular patch of each image of s is
+// replaced with the corresponding patch of a randomly chosen image of other, as
+// described by Yun et al. "CutMix: Regularization Strategy to Train Strong
+// Classifiers with Localizable Features" ICCV 2019. The patch area is determined
+// by λ drawn from Beta(1, 1), and the label is weighted by the proportion of
+// each image retained.
+func (s *Set) CutMix(other Set, r *rand.Rand) (MixupSet, error) {
+	err := s.checkMixPartner(other)
+	if err != nil {
+		return MixupSet{}, err
+	}
+
+	m := newMixupSet(s.Len(), s.Rows(), s.Cols())
+	for i := 0; i < s.Len(); i++ {
+		lambda := betaRand(1, 1, r)
+		box := cutBox(s.Rows(), s.Cols(), lambda, r)
+		m.cutMix(i, s, i, &other, r.Intn(other.Len()), box)
+	}
+	return m, nil
+}
+
+// cutBox returns a rectangle with area approximately (1-lambda)*rows*cols
+// centred at a uniformly chosen position and clipped to the image.
+func cutBox(rows, cols int, lambda float64, r *rand.Rand) image.Rectangle {
+	ratio := math.Sqrt(1 - lambda)
+	h := int(float64(rows) * ratio)
+	w := int(float64(cols) * ratio)
+	cy := r.Intn(rows)
+	cx := r.Intn(cols)
+	return image.Rect(cx-w/2, cy-h/2, cx+(w+1)/2, cy+(h+1)/2).Intersect(image.Rect(0, 0, cols, rows))
+}
+
+// cutMix stores the i'th image of a with the box region replaced by the
+// j'th image of b into the dst'th element of m, with the label weighted
+// by the retained area.
+func (m *MixupSet) cutMix(dst int, a *Set, i int, b *Set, j int, box image.Rectangle) {
+	li, imgA := a.Index(i)
+	lj, imgB := b.Index(j)
+	img := m.ImageFloat32(dst)
+	for y := 0; y < m.rows; y++ {
+		for x := 0; x < m.cols; x++ {
+			k := y*m.cols + x
+			if (image.Point{X: x, Y: y}).In(box) {
+				img[k] = float32(imgB[k]) / 255
+			} else {
+				img[k] = float32(imgA[k]) / 255
+			}
+		}
+	}
+	lambda := 1 - float64(box.Dx()*box.Dy())/float64(m.rows*m.cols)
+	lv := m.LabelVector(dst)
+	lv[li] += lambda
+	lv[lj] += 1 - lambda
+}
+
+// checkMixPartner returns an error if other cannot be
+// used as a source of partner images for s.
+func (s *Set) checkMixPartner(other Set) error {
+	if other.Len() == 0 {
+		return errors.New("mnist: no samples in partner set")
+	}
+	if other.Rows() != s.Rows() || other.Cols() != s.Cols() {
+		return errors.New("mnist: mismatched image dimensions")
+	}
+	for _, set := range []*Set{s, &other} {
+		for i, l := range set.labels {
+			if l >= numClasses {
+				return fmt.Errorf("mnist: label out of range at %d: %d", i, l)
+			}
+		}
+	}
+	return nil
+}
+
+// betaRand returns a random sample from the Beta(a, b) distribution.
+func betaRand(a, b float64, r *rand.Rand) float64 {
+	x := gammaRand(a, r)
+	y := gammaRand(b, r)
+	if x+y == 0 {
+		// Both samples underflowed, which happens when a and b are
+		// very small. The distribution then concentrates at 0 and 1.
+		if r.Float64() < a/(a+b) {
+			return 1
+		}
+		return 0
+	}
+	return x / (x + y)
+}
+
+// gammaRand returns a random sample from the Gamma(shape, 1) distribution
+// using the method of Marsaglia and Tsang.
+func gammaRand(shape float64, r *rand.Rand) float64 {
+	if shape < 1 {
+		// Boost the shape and correct by U^(1/shape).
+		return gammaRand(shape+1, r) * math.Pow(r.Float64(), 1/shape)
+	}
+	d := shape - 1.0/3
+	c := 1 / math.Sqrt(9*d)
+	for {
+		var x, v float64
+		for v <= 0 {
+			x = r.NormFloat64()
+			v = 1 + c*x
+		}
+		v = v * v * v
+		u := r.Float64()
+		if u < 1-0.0331*x*x*x*x || math.Log(u) < 0.5*x*x+d*(1-v+math.Log(v)) {
+			return d * v
+		}
+	}
+}
@@ -0,0 +1,115 @@
+// Copyright ©2026 The bíogo.nn Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mnist
+
+import (
+	"image"
+	"math"
+	"math/rand"
+	"testing"
+)
+
+func TestMixup(t *testing.T) {
+	src := subset(Test, 1000)
+	for _, test := range []struct {
+		alpha float64
+		check func(i int, lv []float64, label byte)
+	}{
+		{
+			// As alpha grows, λ concentrates at 0.5.
+			alpha: 1e6,
+			check: func(i int, lv []float64, label byte) {
+				if lv[label] < 0.5-1e-2 {
+					t.Errorf("Unexpected weight for own label at %d: got: %v want: ≥0.5", i, lv[label])
+				}
+				for c, w := range lv {
+					if c != int(label) && w > 0.5+1e-2 {
+						t.Errorf("Unexpected weight for class %d at %d: got: %v want: ≤0.5", c, i, w)
+					}
+				}
+			},
+		},
+		{
+			// As alpha shrinks, λ concentrates at 0 and 1.
+			alpha: 1e-6,
+			check: func(i int, lv []float64, label byte) {
+				var max float64
+				for _, w := range lv {
+					max = math.Max(max, w)
+				}
+				if max < 0.99 {
+					t.Errorf("Unexpected maximum label weight at %d: got: %v want: ≥0.99", i, max)
+				}
+			},
+		},
+	} {
+		m, err := src.Mixup(Train, test.alpha, rand.New(rand.NewSource(1)))
+		if err != nil {
+			t.Fatalf("Unexpected error for alpha=%v: %v", test.alpha, err)
+		}
+		if m.Len() != src.Len() || m.Rows() != src.Rows() || m.Cols() != src.Cols() {
+			t.Fatalf("Unexpected shape for alpha=%v: got: %d×%d×%d want: %d×%d×%d",
+				test.alpha, m.Len(), m.Rows(), m.Cols(), src.Len(), src.Rows(), src.Cols())
+		}
+		for i := 0; i < m.Len(); i++ {
+			lv := m.LabelVector(i)
+			var sum float64
+			for _, w := range lv {
+				sum += w
+			}
+			if math.Abs(sum-1) > 1e-12 {
+				t.Errorf("Unexpected label vector sum at %d for alpha=%v: got: %v want: 1", i, test.alpha, sum)
+			}
+			for _, v := range m.ImageFloat32(i) {
+				if v < 0 || v > 1 {
+					t.Errorf("Pixel out of range at %d for alpha=%v: %v", i, test.alpha, v)
+					break
+				}
+			}
+			label, _ := src.Index(i)
+			test.check(i, lv, label)
+		}
+	}
+
+	_, err := src.Mixup(Set{}, 1, rand.New(rand.NewSource(1)))
+	if err == nil {
+		t.Error("Expected error for empty partner set")
+	}
+}
+
+func TestCutMix(t *testing.T) {
+	src := subset(Test, 1000)
+	m, err := src.CutMix(Train, rand.New(rand.NewSource(1)))
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if m.Len() != src.Len() || m.Rows() != src.Rows() || m.Cols() != src.Cols() {
+		t.Fatalf("Unexpected shape: got: %d×%d×%d want: %d×%d×%d",
+			m.Len(), m.Rows(), m.Cols(), src.Len(), src.Rows(), src.Cols())
+	}
+	for i := 0; i < m.Len(); i++ {
+		var sum float64
+		for _, w := range m.LabelVector(i) {
+			sum += w
+		}
+		if math.Abs(sum-1) > 1e-12 {
+			t.Errorf("Unexpected label vector sum at %d: got: %v want: 1", i, sum)
+		}
+	}
+
+	// A zero area patch retains the original image.
+	m = newMixupSet(1, src.Rows(), src.Cols())
+	m.cutMix(0, &src, 0, &Train, 0, image.Rectangle{})
+	label, img := src.Index(0)
+	for k, v := range m.ImageFloat32(0) {
+		if v != float32(img[k])/255 {
+			t.Errorf("Unexpected pixel %d with zero area patch: got: %v want: %v", k, v, float32(img[k])/255)
+			break
+		}
+	}
+	if w := m.LabelVector(0)[label]; w != 1 {
+		t.Errorf("Unexpected label weight with zero area patch: got: %v want: 1", w)
+	}
+}
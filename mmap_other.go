@@ -0,0 +1,15 @@
+// Copyright ©2026 The bíogo.nn Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+//go:build mmap && !unix && !windows
+
+package mnist
+
+import "os"
+
+// mapFile returns the contents of the file at path. Memory mapping
+// is not available on this platform, so the file is read into memory.
+func mapFile(path string) ([]byte, error) {
+	return os.ReadFile(path)
+}
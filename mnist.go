@@ -4,24 +4,23 @@
 
 // Package mnist provides a simple interface to access the MNIST database of handwritten digits.
 // The mnist package does not come bundled with the database, but will attempt to download the
-// data if it does not already exist in the package's root directory.
+// data when Load or New is called if it does not already exist in the package's root directory
+// or the directory given by WithDataDir or WithEnvDir. Importing the package does not read or
+// download any data.
 //
 // More information on MNIST is provided at http://yann.lecun.com/exdb/mnist/.
 package mnist
 
 import (
 	"compress/gzip"
-	"crypto/md5"
 	"encoding/binary"
 	"errors"
 	"fmt"
 	"io"
-	"log"
-	"net/http"
-	"net/url"
+	"math"
 	"os"
-	"path/filepath"
-	"runtime"
+	"slices"
+	"sync"
 )
 
 const (
@@ -29,16 +28,27 @@ const (
 	xIMG int32 = 0x00000803
 )
 
-// If Logger is not nil, MNIST data retrieval will be logged.
-var Logger *log.Logger = log.New(os.Stderr, "mnist: ", log.LstdFlags)
+// The dimensions of the standard MNIST digit images.
+const (
+	ImageRows = 28
+	ImageCols = 28
+)
+
+// Dimensions returns the number of pixel rows and columns in
+// the standard MNIST digit images.
+func Dimensions() (rows, cols int) { return ImageRows, ImageCols }
+
+// dataFile describes a remote MNIST data file. A zero length
+// or empty checksum indicates that the value is not known.
+type dataFile struct {
+	url    string
+	length int64
+	md5    string
+	sha256 string
+}
 
 var (
-	mnist = []struct {
-		url    string
-		local  string
-		length int64
-		md5    string
-	}{
+	mnist = []dataFile{
 		/*
 			TRAINING SET IMAGE FILE (train-images-idx3-ubyte):
 			[offset] [type]          [value]          [description]
@@ -57,6 +67,7 @@ var (
 			url:    "http://yann.lecun.com/exdb/mnist/train-images-idx3-ubyte.gz",
 			length: 9912422,
 			md5:    "f68b3c2dcbeaaa9fbdd348bbdeb94873",
+			sha256: "440fcabf73cc546fa21475e81ea370265605f56be210a4024d2ca8f203523609",
 		},
 
 		/*
@@ -75,6 +86,7 @@ var (
 			url:    "http://yann.lecun.com/exdb/mnist/train-labels-idx1-ubyte.gz",
 			length: 28881,
 			md5:    "d53e105ee54ea40749a09fcbcd1e9432",
+			sha256: "3552534a0a558bbed6aed32b30c495cca23d567ec52cac8be1a0730e8010255c",
 		},
 
 		/*
@@ -95,6 +107,7 @@ var (
 			url:    "http://yann.lecun.com/exdb/mnist/t10k-images-idx3-ubyte.gz",
 			length: 1648877,
 			md5:    "9fb629c4189551a2d022fa330f9573f3",
+			sha256: "8d422c7b0a1c1c79245a5bcf07fe86e33eeafee792b84584aec276f5a2dbc4e6",
 		},
 
 		/*
@@ -113,82 +126,18 @@ var (
 			url:    "http://yann.lecun.com/exdb/mnist/t10k-labels-idx1-ubyte.gz",
 			length: 4542,
 			md5:    "ec29112dd5afa0611ce80d1b7f02629c",
+			sha256: "f7ae60f92e00ec6debd23a6088c31dbd2371eca3ffa0defaefb259924204aec6",
 		},
 	}
 )
 
-func init() {
-	_, path, _, ok := runtime.Caller(0)
-	if !ok {
-		if Logger != nil {
-			Logger.Fatal("cannot get file location")
-		}
-		fmt.Fprintf(os.Stderr, "mnist: cannot get file location")
-		os.Exit(1)
-	}
-	dir := filepath.Dir(path)
-
-	if Logger != nil {
-		Logger.Print("Checking for MNIST data...")
-	}
-	cl := &http.Client{}
-	for i := range mnist {
-		u, err := url.Parse(mnist[i].url)
-		isNil(err)
-		fn := filepath.Base(u.Path)
-		mnist[i].local = filepath.Join(dir, fn)
-		if f, err := os.Open(mnist[i].local); err == nil {
-			if fs, err := f.Stat(); err == nil && fs.Size() == mnist[i].length {
-				hash := md5.New()
-				n, err := io.Copy(hash, f)
-				isNil(err)
-				s := hash.Sum(nil)
-				if n == mnist[i].length && fmt.Sprintf("%x", s) == mnist[i].md5 {
-					if Logger != nil {
-						Logger.Printf(" %s: OK", fn)
-					}
-					continue
-				}
-			}
-		}
-		if Logger != nil {
-			Logger.Printf(" %s: Downloading", fn)
-		}
-		res, err := cl.Get(mnist[i].url)
-		isNil(err)
-		f, err := os.Create(mnist[i].local)
-		isNil(err)
-		n, err := io.Copy(f, res.Body)
-		if n != mnist[i].length {
-			if Logger != nil {
-				Logger.Fatalf("length mismatch %d != %d", n, mnist[i].length)
-			}
-			fmt.Fprintf(os.Stderr, "mnist: length mismatch %d != %d", n, mnist[i].length)
-			os.Exit(1)
-		}
-		isNil(err)
-		res.Body.Close()
-		f.Close()
-	}
-
-	isNil(Train.read(mnist[0].local, mnist[1].local))
-	isNil(Test.read(mnist[2].local, mnist[3].local))
-}
-
-func isNil(err error) {
-	if err != nil {
-		if Logger != nil {
-			Logger.Fatal(err)
-		}
-		panic(fmt.Sprintf("mnist: %v", err))
-	}
-}
-
 var (
-	// Train contains the MNIST training set of 60,000 digits with labels.
+	// Train contains the MNIST training set of 60,000 digits with
+	// labels. It is empty until Load has been called successfully.
 	Train Set
 
 	// Test contains the MNIST test set of 10,000 digits with labels.
+	// It is empty until Load has been called successfully.
 	Test Set
 )
 
@@ -198,6 +147,43 @@ type Set struct {
 	rows, cols int32
 	matrix     []byte // count*rows*cols
 	labels     []byte // count
+
+	meta Metadata
+}
+
+// NewSet returns a Set holding copies of the given images and labels.
+// Each image must have length rows*cols and there must be one label
+// per image. The returned Set is checked with Validate.
+func NewSet(images [][]byte, labels []byte, rows, cols int) (Set, error) {
+	if rows <= 0 || cols <= 0 || rows > math.MaxInt32 || cols > math.MaxInt32 {
+		return Set{}, fmt.Errorf("mnist: invalid image dimensions: %d×%d", rows, cols)
+	}
+	if len(images) != len(labels) {
+		return Set{}, fmt.Errorf("mnist: mismatched number of images and labels: %d != %d", len(images), len(labels))
+	}
+	if len(images) > math.MaxInt32 {
+		return Set{}, errors.New("mnist: too many images")
+	}
+	stride := rows * cols
+	matrix := make([]byte, 0, len(images)*stride)
+	for i, img := range images {
+		if len(img) != stride {
+			return Set{}, fmt.Errorf("mnist: image %d has length %d, want %d", i, len(img), stride)
+		}
+		matrix = append(matrix, img...)
+	}
+	s := Set{
+		count:  int32(len(images)),
+		rows:   int32(rows),
+		cols:   int32(cols),
+		matrix: matrix,
+		labels: append([]byte{}, labels...),
+	}
+	err := s.Validate()
+	if err != nil {
+		return Set{}, err
+	}
+	return s, nil
 }
 
 // Rows returns the number of pixel rows in the images of the data set.
@@ -206,6 +192,9 @@ func (s *Set) Rows() int { return int(s.rows) }
 // Cols returns the number of pixel columns in the images of the data set.
 func (s *Set) Cols() int { return int(s.cols) }
 
+// Dimensions returns the number of pixel rows and columns in the images of the data set.
+func (s *Set) Dimensions() (rows, cols int) { return int(s.rows), int(s.cols) }
+
 // Len returns the number of labelled images in the data set.
 func (s *Set) Len() int { return int(s.count) }
 
@@ -215,28 +204,101 @@ func (s *Set) Index(i int) (label byte, image []byte) {
 	return s.labels[i], s.matrix[i*stride : (i+1)*stride]
 }
 
-func (s *Set) read(images, labels string) error {
-	err := s.readImages(images)
-	if err != nil {
-		return err
+// At returns the value of the pixel at row r and column c
+// of the i'th image of the data set.
+func (s *Set) At(i, r, c int) byte {
+	_, img := s.Index(i)
+	return img[r*s.Cols()+c]
+}
+
+// Labels returns a copy of the labels of the data set.
+func (s *Set) Labels() []byte {
+	return append([]byte(nil), s.labels...)
+}
+
+// Images returns a copy of the pixels of all the images of the data set.
+// The i'th image is held in the elements [i*rows*cols, (i+1)*rows*cols).
+func (s *Set) Images() []byte {
+	return append([]byte(nil), s.matrix...)
+}
+
+// Scan copies the pixels of the i'th image of the data set into dst. Unlike the
+// slice returned by Index, dst may be modified without altering the data set.
+// Scan returns an error if dst is shorter than s.Rows()*s.Cols().
+func (s *Set) Scan(i int, dst []byte) error {
+	_, img := s.Index(i)
+	if len(dst) < len(img) {
+		return errors.New("mnist: destination too short")
 	}
-	return s.readLabels(labels)
+	copy(dst, img)
+	return nil
 }
 
-func (s *Set) readImages(file string) error {
-	f, err := os.Open(file)
-	if err != nil {
-		return err
+// ScanFloat32 copies the pixels of the i'th image of the data set into dst
+// scaled to [0,1]. ScanFloat32 returns an error if dst is shorter than
+// s.Rows()*s.Cols().
+func (s *Set) ScanFloat32(i int, dst []float32) error {
+	_, img := s.Index(i)
+	if len(dst) < len(img) {
+		return errors.New("mnist: destination too short")
 	}
-	defer f.Close()
-	z, err := gzip.NewReader(f)
+	for j, v := range img {
+		dst[j] = float32(v) / 255
+	}
+	return nil
+}
+
+// Pool returns a channel buffered with n pre-allocated image buffers, each
+// with length s.Rows()*s.Cols(). Callers take a buffer from the channel and
+// send it back to the channel when it is no longer needed.
+func (s *Set) Pool(n int) chan []byte {
+	pool := make(chan []byte, n)
+	stride := s.Rows() * s.Cols()
+	for i := 0; i < n; i++ {
+		pool <- make([]byte, stride)
+	}
+	return pool
+}
+
+// ForEachParallel calls fn for each labelled image in the data set. The set is
+// split into concurrency contiguous ranges that are each processed in a separate
+// goroutine, so fn must be safe for concurrent use. The image slice passed to fn
+// is the same as that returned by Index. ForEachParallel returns when all calls
+// to fn have returned.
+func (s *Set) ForEachParallel(concurrency int, fn func(i int, label byte, image []byte)) {
+	n := s.Len()
+	if concurrency < 1 {
+		concurrency = 1
+	}
+	if concurrency > n {
+		concurrency = n
+	}
+	var wg sync.WaitGroup
+	for k := 0; k < concurrency; k++ {
+		wg.Add(1)
+		go func(from, to int) {
+			defer wg.Done()
+			for i := from; i < to; i++ {
+				label, image := s.Index(i)
+				fn(i, label, image)
+			}
+		}(k*n/concurrency, (k+1)*n/concurrency)
+	}
+	wg.Wait()
+}
+
+func (s *Set) read(images, labels string) error {
+	err := s.readImages(images)
 	if err != nil {
 		return err
 	}
-	defer z.Close()
+	return s.readLabels(labels)
+}
 
+// decodeImages reads IDX image data from r.
+func (s *Set) decodeImages(r io.Reader) error {
 	var magic int32
-	err = binary.Read(z, binary.BigEndian, &magic)
+	err := binary.Read(r, binary.BigEndian, &magic)
 	if err != nil {
 		return err
 	}
@@ -244,17 +306,44 @@ func (s *Set) readImages(file string) error {
 		return fmt.Errorf("invalid magic number for images: %x", magic)
 	}
 	for _, v := range []*int32{&s.count, &s.rows, &s.cols} {
-		err = binary.Read(z, binary.BigEndian, v)
+		err = binary.Read(r, binary.BigEndian, v)
 		if err != nil {
 			return err
 		}
 	}
-	s.matrix = make([]byte, s.count*s.rows*s.cols)
-	_, err = io.ReadFull(z, s.matrix)
+	if s.count < 0 || s.rows < 0 || s.cols < 0 {
+		return errors.New("invalid image dimensions")
+	}
+	stride := int64(s.rows) * int64(s.cols)
+	if stride != 0 && int64(s.count) > math.MaxInt/stride {
+		return errors.New("image data too large")
+	}
+	s.matrix, err = readN(r, int64(s.count)*stride)
 
 	return err
 }
 
+// readN returns the next n bytes read from r. The returned slice is grown
+// as data is read so that a corrupt length does not cause a large allocation
+// before the data has been seen.
+func readN(r io.Reader, n int64) ([]byte, error) {
+	const minChunk = 1 << 20
+	buf := make([]byte, 0, min(n, minChunk))
+	for int64(len(buf)) < n {
+		chunk := int(min(n-int64(len(buf)), max(int64(len(buf)), minChunk)))
+		buf = slices.Grow(buf, chunk)
+		m, err := io.ReadFull(r, buf[len(buf):len(buf)+chunk])
+		buf = buf[:len(buf)+m]
+		if err != nil {
+			if err == io.EOF {
+				err = io.ErrUnexpectedEOF
+			}
+			return nil, err
+		}
+	}
+	return buf, nil
+}
+
 func (s *Set) readLabels(file string) error {
 	f, err := os.Open(file)
 	if err != nil {
@@ -266,9 +355,14 @@ func (s *Set) readLabels(file string) error {
 		return err
 	}
 	defer z.Close()
+	return s.decodeLabels(z)
+}
 
+// decodeLabels reads IDX label data from r. The number of labels
+// must match the number of images already held by s.
+func (s *Set) decodeLabels(r io.Reader) error {
 	var magic int32
-	err = binary.Read(z, binary.BigEndian, &magic)
+	err := binary.Read(r, binary.BigEndian, &magic)
 	if err != nil {
 		return err
 	}
@@ -276,15 +370,38 @@ func (s *Set) readLabels(file string) error {
 		return fmt.Errorf("invalid magic number for labels: %x", magic)
 	}
 	var count int32
-	err = binary.Read(z, binary.BigEndian, &count)
+	err = binary.Read(r, binary.BigEndian, &count)
 	if err != nil {
 		return err
 	}
 	if count != s.count {
 		return errors.New("mismatched number of labels and images")
 	}
-	s.labels = make([]byte, s.count)
-	_, err = io.ReadFull(z, s.labels)
+	s.labels, err = readN(r, int64(s.count))
+
+	return err
+}
+
+// encodeImages writes the images of the set to w in IDX format.
+func (s *Set) encodeImages(w io.Writer) error {
+	for _, v := range []int32{xIMG, s.count, s.rows, s.cols} {
+		err := binary.Write(w, binary.BigEndian, v)
+		if err != nil {
+			return err
+		}
+	}
+	_, err := w.Write(s.matrix)
+	return err
+}
 
+// encodeLabels writes the labels of the set to w in IDX format.
+func (s *Set) encodeLabels(w io.Writer) error {
+	for _, v := range []int32{xLAB, s.count} {
+		err := binary.Write(w, binary.BigEndian, v)
+		if err != nil {
+			return err
+		}
+	}
+	_, err := w.Write(s.labels)
 	return err
 }
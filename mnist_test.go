@@ -5,9 +5,24 @@
 package mnist
 
 import (
+	"bytes"
+	"fmt"
+	"os"
+	"sync/atomic"
 	"testing"
 )
 
+// TestMain loads Train and Test, which most tests use, from the directory
+// named by MNIST_DATA_DIR or the package's root directory.
+func TestMain(m *testing.M) {
+	err := Load(WithEnvDir("MNIST_DATA_DIR"))
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "mnist: cannot load test data: %v\n", err)
+		os.Exit(1)
+	}
+	os.Exit(m.Run())
+}
+
 func TestMnist(t *testing.T) {
 	for _, test := range []struct {
 		set  Set
@@ -48,3 +63,211 @@ func TestMnist(t *testing.T) {
 		}
 	}
 }
+
+func TestPool(t *testing.T) {
+	for _, n := range []int{0, 1, 8} {
+		pool := Train.Pool(n)
+		if len(pool) != n {
+			t.Errorf("Unexpected pool length: got: %d want: %d", len(pool), n)
+		}
+		if cap(pool) != n {
+			t.Errorf("Unexpected pool capacity: got: %d want: %d", cap(pool), n)
+		}
+		for i := 0; i < n; i++ {
+			buf := <-pool
+			if len(buf) != Train.Rows()*Train.Cols() {
+				t.Errorf("Unexpected buffer length: got: %d want: %d", len(buf), Train.Rows()*Train.Cols())
+			}
+		}
+	}
+}
+
+func TestForEachParallel(t *testing.T) {
+	for _, concurrency := range []int{1, 3, 8} {
+		seen := make([]int32, Test.Len())
+		Test.ForEachParallel(concurrency, func(i int, label byte, image []byte) {
+			atomic.AddInt32(&seen[i], 1)
+			wantLabel, wantImage := Test.Index(i)
+			if label != wantLabel || &image[0] != &wantImage[0] {
+				t.Errorf("Unexpected sample for index %d with concurrency %d", i, concurrency)
+			}
+		})
+		for i, n := range seen {
+			if n != 1 {
+				t.Errorf("Unexpected number of calls for index %d with concurrency %d: got: %d want: 1", i, concurrency, n)
+			}
+		}
+	}
+}
+
+func TestScan(t *testing.T) {
+	dst := make([]byte, Test.Rows()*Test.Cols())
+	for _, i := range []int{0, 1, 100, Test.Len() - 1} {
+		err := Test.Scan(i, dst)
+		if err != nil {
+			t.Errorf("Unexpected error for image %d: %v", i, err)
+			continue
+		}
+		_, img := Test.Index(i)
+		if !bytes.Equal(dst, img) {
+			t.Errorf("Unexpected pixels for image %d", i)
+		}
+		dst[0]++
+		if dst[0] == img[0] {
+			t.Errorf("Scan destination aliases data set for image %d", i)
+		}
+	}
+	err := Test.Scan(0, dst[:len(dst)-1])
+	if err == nil {
+		t.Error("Expected error for short destination")
+	}
+}
+
+func TestScanFloat32(t *testing.T) {
+	dst := make([]float32, Test.Rows()*Test.Cols())
+	for _, i := range []int{0, 1, 100, Test.Len() - 1} {
+		err := Test.ScanFloat32(i, dst)
+		if err != nil {
+			t.Errorf("Unexpected error for image %d: %v", i, err)
+			continue
+		}
+		_, img := Test.Index(i)
+		for j, v := range img {
+			if want := float32(v) / 255; dst[j] != want {
+				t.Errorf("Unexpected pixel %d for image %d: got: %v want: %v", j, i, dst[j], want)
+				break
+			}
+		}
+	}
+	err := Test.ScanFloat32(0, dst[:len(dst)-1])
+	if err == nil {
+		t.Error("Expected error for short destination")
+	}
+}
+
+func BenchmarkScan(b *testing.B) {
+	dst := make([]byte, Train.Rows()*Train.Cols())
+	for i := 0; i < b.N; i++ {
+		Train.Scan(i%Train.Len(), dst)
+	}
+}
+
+func BenchmarkCopyIndex(b *testing.B) {
+	dst := make([]byte, Train.Rows()*Train.Cols())
+	for i := 0; i < b.N; i++ {
+		_, img := Train.Index(i % Train.Len())
+		copy(dst, img)
+	}
+}
+
+func TestDimensions(t *testing.T) {
+	rows, cols := Dimensions()
+	if rows != ImageRows || cols != ImageCols {
+		t.Errorf("Unexpected package dimensions: got: %d×%d want: %d×%d", rows, cols, ImageRows, ImageCols)
+	}
+	for _, test := range []struct {
+		set  Set
+		name string
+	}{
+		{set: Test, name: "Test"},
+		{set: Train, name: "Train"},
+	} {
+		rows, cols := test.set.Dimensions()
+		if rows != test.set.Rows() || cols != test.set.Cols() {
+			t.Errorf("Unexpected dimensions for %q: got: %d×%d want: %d×%d", test.name, rows, cols, test.set.Rows(), test.set.Cols())
+		}
+		if rows != ImageRows || cols != ImageCols {
+			t.Errorf("Unexpected dimensions for %q: got: %d×%d want: %d×%d", test.name, rows, cols, ImageRows, ImageCols)
+		}
+	}
+}
+
+func TestAt(t *testing.T) {
+	for _, i := range []int{0, 1, 100, Test.Len() - 1} {
+		_, img := Test.Index(i)
+		for r := 0; r < Test.Rows(); r++ {
+			for c := 0; c < Test.Cols(); c++ {
+				if got, want := Test.At(i, r, c), img[r*Test.Cols()+c]; got != want {
+					t.Fatalf("Unexpected pixel at image %d (%d, %d): got: %d want: %d", i, r, c, got, want)
+				}
+			}
+		}
+	}
+}
+
+func TestLabelsImages(t *testing.T) {
+	labels := Test.Labels()
+	if len(labels) != Test.Len() {
+		t.Errorf("Unexpected number of labels: got: %d want: %d", len(labels), Test.Len())
+	}
+	if !bytes.Equal(labels, Test.labels) {
+		t.Error("Unexpected label values")
+	}
+	labels[0]++
+	if labels[0] == Test.labels[0] {
+		t.Error("Labels result aliases data set")
+	}
+
+	images := Test.Images()
+	if len(images) != Test.Len()*Test.Rows()*Test.Cols() {
+		t.Errorf("Unexpected number of pixels: got: %d want: %d", len(images), Test.Len()*Test.Rows()*Test.Cols())
+	}
+	if !bytes.Equal(images, Test.matrix) {
+		t.Error("Unexpected pixel values")
+	}
+	images[0]++
+	if images[0] == Test.matrix[0] {
+		t.Error("Images result aliases data set")
+	}
+}
+
+func TestNewSet(t *testing.T) {
+	const n = 50
+	images := make([][]byte, n)
+	for i := range images {
+		_, images[i] = Test.Index(i)
+	}
+	labels := Test.labels[:n]
+	got, err := NewSet(images, labels, Test.Rows(), Test.Cols())
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	err = got.Validate()
+	if err != nil {
+		t.Errorf("Unexpected validation error: %v", err)
+	}
+	if got.Len() != n || got.Rows() != Test.Rows() || got.Cols() != Test.Cols() {
+		t.Errorf("Unexpected shape: got: %d×%d×%d want: %d×%d×%d",
+			got.Len(), got.Rows(), got.Cols(), n, Test.Rows(), Test.Cols())
+	}
+	for i := 0; i < n; i++ {
+		label, img := got.Index(i)
+		if label != labels[i] {
+			t.Errorf("Unexpected label for image %d: got: %d want: %d", i, label, labels[i])
+		}
+		if !bytes.Equal(img, images[i]) {
+			t.Errorf("Unexpected pixels for image %d", i)
+		}
+	}
+	got.matrix[0]++
+	if got.matrix[0] == Test.matrix[0] {
+		t.Error("NewSet result aliases input images")
+	}
+
+	for _, test := range []struct {
+		name       string
+		images     [][]byte
+		labels     []byte
+		rows, cols int
+	}{
+		{name: "mismatched labels", images: images, labels: labels[:n-1], rows: Test.Rows(), cols: Test.Cols()},
+		{name: "short image", images: [][]byte{make([]byte, 3)}, labels: []byte{1}, rows: 2, cols: 2},
+		{name: "zero rows", images: [][]byte{{}}, labels: []byte{1}, rows: 0, cols: 2},
+		{name: "invalid label", images: [][]byte{make([]byte, 4)}, labels: []byte{10}, rows: 2, cols: 2},
+	} {
+		_, err := NewSet(test.images, test.labels, test.rows, test.cols)
+		if err == nil {
+			t.Errorf("Expected error for %s", test.name)
+		}
+	}
+}
@@ -0,0 +1,41 @@
+// Copyright ©2026 The bíogo.nn Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mnist
+
+import "slices"
+
+// ConfidenceFilter returns a new Set holding the labelled images of the data
+// set for which the maximum of the class scores returned by model is at least
+// minConfidence. The model is called once for each image, in order, and would
+// typically return softmax probabilities. Images for which model returns no
+// scores are excluded. The model must not modify the image it is passed.
+func (s *Set) ConfidenceFilter(model func(image []byte) []float64, minConfidence float64) Set {
+	var idx []int
+	for i := 0; i < s.Len(); i++ {
+		_, img := s.Index(i)
+		scores := model(img)
+		if len(scores) == 0 {
+			continue
+		}
+		if slices.Max(scores) >= minConfidence {
+			idx = append(idx, i)
+		}
+	}
+	return s.take(idx)
+}
+
+// Relabel returns a copy of the data set where the label of each image is
+// replaced by the label predicted for it by model. The model is called once
+// for each image, in order, and must not modify the image it is passed. The
+// image data of the returned Set is shared with s.
+func (s *Set) Relabel(model func(image []byte) byte) Set {
+	c := *s
+	c.labels = make([]byte, len(s.labels))
+	for i := range c.labels {
+		_, img := s.Index(i)
+		c.labels[i] = model(img)
+	}
+	return c
+}
@@ -0,0 +1,64 @@
+// Copyright ©2026 The bíogo.nn Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mnist
+
+import (
+	"bytes"
+	"testing"
+)
+
+func TestConfidenceFilter(t *testing.T) {
+	s := subset(Test, 101)
+	var calls int
+	model := func(image []byte) []float64 {
+		conf := 0.0
+		if calls%2 == 0 {
+			conf = 1
+		}
+		calls++
+		return []float64{(1 - conf) / 2, conf, (1 - conf) / 2}
+	}
+	got := s.ConfidenceFilter(model, 0.9)
+	if calls != s.Len() {
+		t.Errorf("Unexpected number of model calls: got: %d want: %d", calls, s.Len())
+	}
+	want := (s.Len() + 1) / 2
+	if got.Len() != want {
+		t.Fatalf("Unexpected number of retained samples: got: %d want: %d", got.Len(), want)
+	}
+	for i := 0; i < got.Len(); i++ {
+		gotLabel, gotImg := got.Index(i)
+		wantLabel, wantImg := s.Index(2 * i)
+		if gotLabel != wantLabel || !bytes.Equal(gotImg, wantImg) {
+			t.Errorf("Unexpected sample at %d: want source sample %d", i, 2*i)
+		}
+	}
+
+	got = s.ConfidenceFilter(func([]byte) []float64 { return nil }, 0)
+	if got.Len() != 0 {
+		t.Errorf("Unexpected samples retained for empty model output: got: %d want: 0", got.Len())
+	}
+}
+
+func TestRelabel(t *testing.T) {
+	s := subset(Test, 200)
+	orig := s.Labels()
+	got := s.Relabel(func([]byte) byte { return 0 })
+	if !bytes.Equal(s.labels, orig) {
+		t.Error("Relabel altered source labels")
+	}
+	if got.Len() != s.Len() {
+		t.Fatalf("Unexpected length: got: %d want: %d", got.Len(), s.Len())
+	}
+	for i, l := range got.labels {
+		if l != 0 {
+			t.Errorf("Unexpected label at %d: got: %d want: 0", i, l)
+			break
+		}
+	}
+	if !bytes.Equal(got.matrix, s.matrix) {
+		t.Error("Relabel altered images")
+	}
+}
@@ -0,0 +1,80 @@
+// Copyright ©2026 The bíogo.nn Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mnist
+
+import "math"
+
+// Skewness returns the skewness, the third standardised moment, of the
+// row-marginal and column-marginal intensity distributions of the i'th image
+// of the data set. The row-marginal distribution is the distribution of row
+// index weighted by the pixel intensity summed over each row, and similarly
+// for columns. A blank image or an image with all of its intensity in a
+// single row or column returns zero for that axis.
+func (s *Set) Skewness(i int) (rowSkew, colSkew float64) {
+	rows, cols := s.marginals(i)
+	return standardMoment(rows, 3), standardMoment(cols, 3)
+}
+
+// Kurtosis returns the excess kurtosis, the fourth standardised moment less 3,
+// of the row-marginal and column-marginal intensity distributions of the i'th
+// image of the data set, defined as for Skewness. A uniform distribution has
+// an excess kurtosis of -1.2 and a normal distribution has zero. A blank image
+// or an image with all of its intensity in a single row or column returns zero
+// for that axis.
+func (s *Set) Kurtosis(i int) (rowKurt, colKurt float64) {
+	rows, cols := s.marginals(i)
+	return excessKurtosis(rows), excessKurtosis(cols)
+}
+
+// excessKurtosis returns the excess kurtosis of the distribution
+// of indices into weights weighted by their values.
+func excessKurtosis(weights []float64) float64 {
+	k := standardMoment(weights, 4)
+	if k == 0 {
+		return 0
+	}
+	return k - 3
+}
+
+// marginals returns the pixel intensities of the i'th image
+// summed over each row and over each column.
+func (s *Set) marginals(i int) (rows, cols []float64) {
+	r, c := s.Dimensions()
+	_, img := s.Index(i)
+	rows = make([]float64, r)
+	cols = make([]float64, c)
+	for y := 0; y < r; y++ {
+		for x, v := range img[y*c : (y+1)*c] {
+			rows[y] += float64(v)
+			cols[x] += float64(v)
+		}
+	}
+	return rows, cols
+}
+
+// standardMoment returns the k'th standardised moment of the
+// distribution of indices into weights weighted by their values.
+func standardMoment(weights []float64, k int) float64 {
+	var total, mean float64
+	for x, w := range weights {
+		total += w
+		mean += float64(x) * w
+	}
+	if total == 0 {
+		return 0
+	}
+	mean /= total
+	var variance, moment float64
+	for x, w := range weights {
+		d := float64(x) - mean
+		variance += d * d * w
+		moment += math.Pow(d, float64(k)) * w
+	}
+	variance /= total
+	if variance == 0 {
+		return 0
+	}
+	return moment / total / math.Pow(variance, float64(k)/2)
+}
@@ -0,0 +1,93 @@
+// Copyright ©2026 The bíogo.nn Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mnist
+
+import (
+	"bytes"
+	"math"
+	"testing"
+)
+
+func TestSkewness(t *testing.T) {
+	zero := drawSet(
+		"..........",
+		"...####...",
+		"..#....#..",
+		".#......#.",
+		".#......#.",
+		".#......#.",
+		".#......#.",
+		"..#....#..",
+		"...####...",
+		"..........",
+	)
+	rowSkew, colSkew := zero.Skewness(0)
+	if math.Abs(rowSkew) > 1e-12 || math.Abs(colSkew) > 1e-12 {
+		t.Errorf("Unexpected skewness for symmetric digit: got: (%v, %v) want: (0, 0)", rowSkew, colSkew)
+	}
+
+	seven := drawSet(
+		"######",
+		".....#",
+		"....#.",
+		"...#..",
+		"..#...",
+		".#....",
+	)
+	rowSkew, colSkew = seven.Skewness(0)
+	if rowSkew <= 0 {
+		t.Errorf("Unexpected row skewness for top-heavy digit: got: %v want: >0", rowSkew)
+	}
+	if colSkew >= 0 {
+		t.Errorf("Unexpected column skewness for right-heavy digit: got: %v want: <0", colSkew)
+	}
+
+	blank := drawSet("....", "....")
+	rowSkew, colSkew = blank.Skewness(0)
+	if rowSkew != 0 || colSkew != 0 {
+		t.Errorf("Unexpected skewness for blank image: got: (%v, %v) want: (0, 0)", rowSkew, colSkew)
+	}
+}
+
+func TestKurtosis(t *testing.T) {
+	flat := Set{count: 1, rows: 28, cols: 28, matrix: bytes.Repeat([]byte{128}, 28*28), labels: []byte{0}}
+	rowKurt, colKurt := flat.Kurtosis(0)
+	// The excess kurtosis of a discrete uniform distribution over
+	// n values is -6(n²+1)/5(n²-1), approaching -1.2 as n grows.
+	const tol = 0.01
+	if math.Abs(rowKurt+1.2) > tol || math.Abs(colKurt+1.2) > tol {
+		t.Errorf("Unexpected kurtosis for flat image: got: (%v, %v) want: (-1.2, -1.2)", rowKurt, colKurt)
+	}
+
+	peaked := drawSet(
+		".......",
+		".......",
+		"...#...",
+		"..###..",
+		"...#...",
+		".......",
+		".......",
+	)
+	bar := drawSet(
+		".......",
+		".......",
+		".......",
+		"#######",
+		".......",
+		".......",
+		".......",
+	)
+	peakRow, _ := peaked.Kurtosis(0)
+	_, barCol := bar.Kurtosis(0)
+	if peakRow <= barCol {
+		t.Errorf("Unexpected kurtosis ordering: got: peaked=%v flat=%v", peakRow, barCol)
+	}
+
+	blank := drawSet("....", "....")
+	rowKurt, colKurt = blank.Kurtosis(0)
+	if rowKurt != 0 || colKurt != 0 {
+		t.Errorf("Unexpected kurtosis for blank image: got: (%v, %v) want: (0, 0)", rowKurt, colKurt)
+	}
+}
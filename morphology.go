@@ -0,0 +1,137 @@
+// Copyright ©2026 The bíogo.nn Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mnist
+
+// Erode returns a copy of the data set where each pixel is replaced by the
+// minimum of the (2*radius+1)×(2*radius+1) square neighbourhood centred on
+// it. Neighbourhoods are truncated at the edges of the image. Erode panics
+// if radius is negative.
+func (s *Set) Erode(radius int) Set {
+	return s.morph(radius, func(a, b byte) byte { return min(a, b) })
+}
+
+// Dilate returns a copy of the data set where each pixel is replaced by the
+// maximum of the (2*radius+1)×(2*radius+1) square neighbourhood centred on
+// it. Neighbourhoods are truncated at the edges of the image. Dilate panics
+// if radius is negative.
+func (s *Set) Dilate(radius int) Set {
+	return s.morph(radius, func(a, b byte) byte { return max(a, b) })
+}
+
+// Opening returns the result of eroding and then dilating the data set with
+// the square structuring element used by Erode and Dilate. Opening removes
+// foreground features smaller than the structuring element.
+func (s *Set) Opening(radius int) Set {
+	e := s.Erode(radius)
+	return e.Dilate(radius)
+}
+
+// Closing returns the result of dilating and then eroding the data set with
+// the square structuring element used by Erode and Dilate. Closing fills
+// background gaps smaller than the structuring element.
+func (s *Set) Closing(radius int) Set {
+	d := s.Dilate(radius)
+	return d.Erode(radius)
+}
+
+// Thinning returns a copy of the data set where the foreground of each image
+// has been reduced to a skeleton one pixel wide using the iterative algorithm
+// of Zhang and Suen, "A fast parallel algorithm for thinning digital patterns"
+// Commun. ACM 27(3) 1984. Pixels with values of at least 128 are foreground.
+// The returned images are binary with foreground pixels set to 255. Pixels
+// beyond the edge of the image are treated as background.
+func (s *Set) Thinning() Set {
+	rows, cols := s.Dimensions()
+	var remove []int
+	return s.mapImages(func(dst, src []byte) {
+		for j, v := range src {
+			if v >= strokeThreshold {
+				dst[j] = 255
+			} else {
+				dst[j] = 0
+			}
+		}
+		at := func(y, x int) int {
+			if y < 0 || y >= rows || x < 0 || x >= cols || dst[y*cols+x] == 0 {
+				return 0
+			}
+			return 1
+		}
+		for {
+			var changed bool
+			for step := 0; step < 2; step++ {
+				remove = remove[:0]
+				for y := 0; y < rows; y++ {
+					for x := 0; x < cols; x++ {
+						if dst[y*cols+x] == 0 {
+							continue
+						}
+						// Neighbours P2 to P9, clockwise from north.
+						p := [8]int{
+							at(y-1, x), at(y-1, x+1), at(y, x+1), at(y+1, x+1),
+							at(y+1, x), at(y+1, x-1), at(y, x-1), at(y-1, x-1),
+						}
+						var b, a int
+						for k, v := range p {
+							b += v
+							if v == 0 && p[(k+1)%8] == 1 {
+								a++
+							}
+						}
+						if b < 2 || b > 6 || a != 1 {
+							continue
+						}
+						p2, p4, p6, p8 := p[0], p[2], p[4], p[6]
+						if step == 0 && (p2*p4*p6 != 0 || p4*p6*p8 != 0) {
+							continue
+						}
+						if step == 1 && (p2*p4*p8 != 0 || p2*p6*p8 != 0) {
+							continue
+						}
+						remove = append(remove, y*cols+x)
+					}
+				}
+				for _, j := range remove {
+					dst[j] = 0
+				}
+				changed = changed || len(remove) != 0
+			}
+			if !changed {
+				return
+			}
+		}
+	})
+}
+
+// morph returns a copy of the data set where each pixel is replaced by the
+// reduction with op of its square neighbourhood of the given radius. The
+// square is reduced separably, first along rows and then along columns.
+func (s *Set) morph(radius int, op func(a, b byte) byte) Set {
+	if radius < 0 {
+		panic("mnist: negative structuring element radius")
+	}
+	rows, cols := s.Dimensions()
+	work := make([]byte, rows*cols)
+	return s.mapImages(func(dst, src []byte) {
+		for y := 0; y < rows; y++ {
+			for x := 0; x < cols; x++ {
+				v := src[y*cols+x]
+				for xx := max(x-radius, 0); xx <= min(x+radius, cols-1); xx++ {
+					v = op(v, src[y*cols+xx])
+				}
+				work[y*cols+x] = v
+			}
+		}
+		for y := 0; y < rows; y++ {
+			for x := 0; x < cols; x++ {
+				v := work[y*cols+x]
+				for yy := max(y-radius, 0); yy <= min(y+radius, rows-1); yy++ {
+					v = op(v, work[yy*cols+x])
+				}
+				dst[y*cols+x] = v
+			}
+		}
+	})
+}
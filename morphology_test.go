@@ -0,0 +1,212 @@
+// Copyright ©2026 The bíogo.nn Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mnist
+
+import (
+	"bytes"
+	"testing"
+)
+
+var morphologyTests = []struct {
+	name   string
+	op     func(s *Set, radius int) Set
+	radius int
+	image  []string
+	want   []string
+}{
+	{
+		name:   "erode square",
+		op:     (*Set).Erode,
+		radius: 1,
+		image: []string{
+			".......",
+			".#####.",
+			".#####.",
+			".#####.",
+			".......",
+		},
+		want: []string{
+			".......",
+			".......",
+			"..###..",
+			".......",
+			".......",
+		},
+	},
+	{
+		name:   "dilate point",
+		op:     (*Set).Dilate,
+		radius: 1,
+		image: []string{
+			".....",
+			".....",
+			"..#..",
+			".....",
+			".....",
+		},
+		want: []string{
+			".....",
+			".###.",
+			".###.",
+			".###.",
+			".....",
+		},
+	},
+	{
+		name:   "opening point",
+		op:     (*Set).Opening,
+		radius: 1,
+		image: []string{
+			".....",
+			".....",
+			"..#..",
+			".....",
+			".....",
+		},
+		want: []string{
+			".....",
+			".....",
+			".....",
+			".....",
+			".....",
+		},
+	},
+	{
+		name:   "opening keeps block",
+		op:     (*Set).Opening,
+		radius: 1,
+		image: []string{
+			"#......",
+			"..###..",
+			"..###..",
+			"..###..",
+			"......#",
+		},
+		want: []string{
+			".......",
+			"..###..",
+			"..###..",
+			"..###..",
+			".......",
+		},
+	},
+	{
+		name:   "closing gap",
+		op:     (*Set).Closing,
+		radius: 1,
+		image: []string{
+			"...........",
+			"...........",
+			"..###.###..",
+			"..###.###..",
+			"..###.###..",
+			"...........",
+			"...........",
+		},
+		want: []string{
+			"...........",
+			"...........",
+			"..#######..",
+			"..#######..",
+			"..#######..",
+			"...........",
+			"...........",
+		},
+	},
+}
+
+func TestMorphology(t *testing.T) {
+	for _, test := range morphologyTests {
+		s := drawSet(test.image...)
+		orig := s.Images()
+		got := test.op(&s, test.radius)
+		if !bytes.Equal(s.matrix, orig) {
+			t.Errorf("Source data altered for %s", test.name)
+		}
+		want := drawSet(test.want...)
+		if !got.Equal(want) {
+			t.Errorf("Unexpected result for %s:\ngot:\n%swant:\n%s", test.name, drawString(got), drawString(want))
+		}
+	}
+
+	s := subset(Test, 20)
+	if got := s.Erode(0); !got.Equal(s) {
+		t.Error("Erode with zero radius altered images")
+	}
+	if got := s.Dilate(0); !got.Equal(s) {
+		t.Error("Dilate with zero radius altered images")
+	}
+}
+
+// drawString returns the first image of s rendered in the
+// format accepted by drawSet.
+func drawString(s Set) string {
+	var buf bytes.Buffer
+	for r := 0; r < s.Rows(); r++ {
+		for c := 0; c < s.Cols(); c++ {
+			if s.At(0, r, c) >= 128 {
+				buf.WriteByte('#')
+			} else {
+				buf.WriteByte('.')
+			}
+		}
+		buf.WriteByte('\n')
+	}
+	return buf.String()
+}
+
+func TestThinning(t *testing.T) {
+	rect := drawSet(
+		"............",
+		".##########.",
+		".##########.",
+		".##########.",
+		".##########.",
+		".##########.",
+		"............",
+	)
+	got := rect.Thinning()
+	var n int
+	for y := 0; y < got.Rows(); y++ {
+		for x := 0; x < got.Cols(); x++ {
+			v := got.At(0, y, x)
+			if v != 0 && v != 255 {
+				t.Fatalf("Unexpected non-binary value at (%d,%d): %d", y, x, v)
+			}
+			if v == 0 {
+				continue
+			}
+			n++
+			if rect.At(0, y, x) == 0 {
+				t.Errorf("Skeleton pixel at (%d,%d) outside rectangle", y, x)
+			}
+			if y+1 < got.Rows() && x+1 < got.Cols() &&
+				got.At(0, y, x+1) != 0 && got.At(0, y+1, x) != 0 && got.At(0, y+1, x+1) != 0 {
+				t.Errorf("Skeleton not one pixel wide at (%d,%d):\n%s", y, x, drawString(got))
+			}
+		}
+	}
+	if n == 0 {
+		t.Fatal("Rectangle thinned to nothing")
+	}
+	// The skeleton of a wide rectangle lies along its
+	// horizontal midline.
+	for x := 3; x <= 7; x++ {
+		if got.At(0, 3, x) != 255 {
+			t.Errorf("Missing midline pixel at (3,%d):\n%s", x, drawString(got))
+		}
+	}
+	if c := got.ConnectedComponents(0, 128); len(c) != 1 {
+		t.Errorf("Unexpected number of skeleton components: got: %d want: 1", len(c))
+	}
+
+	for _, s := range []Set{rect, subset(Test, 100)} {
+		once := s.Thinning()
+		twice := once.Thinning()
+		if !twice.Equal(once) {
+			t.Error("Thinning not idempotent")
+		}
+	}
+}
@@ -0,0 +1,59 @@
+// Copyright ©2026 The bíogo.nn Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mnist
+
+import (
+	"errors"
+	"io"
+
+	"github.com/vmihailenco/msgpack/v5"
+)
+
+// msgpackSet is the MessagePack representation of a Set.
+type msgpackSet struct {
+	Images []byte `msgpack:"images"`
+	Labels []byte `msgpack:"labels"`
+	Rows   int32  `msgpack:"rows"`
+	Cols   int32  `msgpack:"cols"`
+	Count  int32  `msgpack:"count"`
+}
+
+// WriteMessagePack writes the data set to w in MessagePack format as a map with
+// the keys "images" and "labels" holding the pixel and label data as binary
+// values, and "rows", "cols" and "count" holding the dimensions of the set.
+func (s *Set) WriteMessagePack(w io.Writer) error {
+	return msgpack.NewEncoder(w).Encode(msgpackSet{
+		Images: s.matrix,
+		Labels: s.labels,
+		Rows:   s.rows,
+		Cols:   s.cols,
+		Count:  s.count,
+	})
+}
+
+// ReadMessagePack returns a Set read from r in the format written by WriteMessagePack.
+func ReadMessagePack(r io.Reader) (Set, error) {
+	var m msgpackSet
+	err := msgpack.NewDecoder(r).Decode(&m)
+	if err != nil {
+		return Set{}, err
+	}
+	if m.Count < 0 || m.Rows < 0 || m.Cols < 0 {
+		return Set{}, errors.New("mnist: invalid dimensions")
+	}
+	if int64(len(m.Images)) != int64(m.Count)*int64(m.Rows)*int64(m.Cols) {
+		return Set{}, errors.New("mnist: mismatched image data length")
+	}
+	if len(m.Labels) != int(m.Count) {
+		return Set{}, errors.New("mnist: mismatched number of labels and images")
+	}
+	return Set{
+		count:  m.Count,
+		rows:   m.Rows,
+		cols:   m.Cols,
+		matrix: m.Images,
+		labels: m.Labels,
+	}, nil
+}
@@ -0,0 +1,49 @@
+// Copyright ©2026 The bíogo.nn Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mnist
+
+import (
+	"bytes"
+	"encoding/json"
+	"testing"
+)
+
+func TestMessagePack(t *testing.T) {
+	var buf bytes.Buffer
+	err := Test.WriteMessagePack(&buf)
+	if err != nil {
+		t.Fatalf("Unexpected error writing: %v", err)
+	}
+	size := buf.Len()
+
+	got, err := ReadMessagePack(&buf)
+	if err != nil {
+		t.Fatalf("Unexpected error reading: %v", err)
+	}
+	if got.count != Test.count || got.rows != Test.rows || got.cols != Test.cols {
+		t.Errorf("Unexpected shape: got: %d×%d×%d want: %d×%d×%d",
+			got.count, got.rows, got.cols, Test.count, Test.rows, Test.cols)
+	}
+	if !bytes.Equal(got.matrix, Test.matrix) {
+		t.Error("Pixel mismatch after round-trip")
+	}
+	if !bytes.Equal(got.labels, Test.labels) {
+		t.Error("Label mismatch after round-trip")
+	}
+
+	js, err := json.Marshal(msgpackSet{
+		Images: Test.matrix,
+		Labels: Test.labels,
+		Rows:   Test.rows,
+		Cols:   Test.cols,
+		Count:  Test.count,
+	})
+	if err != nil {
+		t.Fatalf("Unexpected error encoding JSON: %v", err)
+	}
+	if size >= len(js) {
+		t.Errorf("MessagePack encoding not smaller than JSON: got: %d bytes JSON: %d bytes", size, len(js))
+	}
+}
@@ -0,0 +1,105 @@
+// Copyright ©2026 The bíogo.nn Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mnist
+
+import (
+	"container/heap"
+	"errors"
+	"math"
+	"sort"
+)
+
+// NearestNeighbor returns the index of the image in the data set closest to query
+// by Euclidean distance, and the distance between them. The length of query must
+// be s.Rows()*s.Cols().
+func (s *Set) NearestNeighbor(query []byte) (int, float64, error) {
+	if len(query) != s.Rows()*s.Cols() {
+		return -1, 0, errors.New("mnist: query length does not match image size")
+	}
+	if s.Len() == 0 {
+		return -1, 0, errors.New("mnist: no samples")
+	}
+	best, bestDist := -1, math.MaxInt
+	for i := 0; i < s.Len(); i++ {
+		_, img := s.Index(i)
+		d := sqDist(query, img)
+		if d < bestDist {
+			best, bestDist = i, d
+		}
+	}
+	return best, math.Sqrt(float64(bestDist)), nil
+}
+
+// KNearestNeighbors returns the indices of the k images in the data set closest
+// to query by Euclidean distance, and their distances, in order of ascending
+// distance. If k is greater than s.Len(), all images are returned. The length of
+// query must be s.Rows()*s.Cols().
+func (s *Set) KNearestNeighbors(query []byte, k int) ([]int, []float64, error) {
+	if len(query) != s.Rows()*s.Cols() {
+		return nil, nil, errors.New("mnist: query length does not match image size")
+	}
+	if k < 1 {
+		return nil, nil, errors.New("mnist: k must be positive")
+	}
+	k = min(k, s.Len())
+
+	// Keep the k closest images seen so far in a
+	// max-heap so the furthest is at the root.
+	h := make(neighbors, 0, k)
+	for i := 0; i < s.Len(); i++ {
+		_, img := s.Index(i)
+		d := sqDist(query, img)
+		switch {
+		case len(h) < k:
+			heap.Push(&h, neighbor{index: i, dist: d})
+		case d < h[0].dist:
+			h[0] = neighbor{index: i, dist: d}
+			heap.Fix(&h, 0)
+		}
+	}
+	sort.Sort(sort.Reverse(h))
+
+	idx := make([]int, len(h))
+	dist := make([]float64, len(h))
+	for i, n := range h {
+		idx[i] = n.index
+		dist[i] = math.Sqrt(float64(n.dist))
+	}
+	return idx, dist, nil
+}
+
+type neighbor struct {
+	index int
+	dist  int
+}
+
+// neighbors is a max-heap of neighbors ordered by distance and then index.
+type neighbors []neighbor
+
+func (h neighbors) Len() int { return len(h) }
+func (h neighbors) Less(i, j int) bool {
+	if h[i].dist == h[j].dist {
+		return h[i].index > h[j].index
+	}
+	return h[i].dist > h[j].dist
+}
+func (h neighbors) Swap(i, j int) { h[i], h[j] = h[j], h[i] }
+func (h *neighbors) Push(x any)   { *h = append(*h, x.(neighbor)) }
+func (h *neighbors) Pop() any {
+	old := *h
+	n := old[len(old)-1]
+	*h = old[:len(old)-1]
+	return n
+}
+
+// sqDist returns the squared Euclidean distance between a and b.
+func sqDist(a, b []byte) int {
+	var d int
+	for i, v := range a {
+		e := int(v) - int(b[i])
+		d += e * e
+	}
+	return d
+}
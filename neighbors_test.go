@@ -0,0 +1,81 @@
+// Copyright ©2026 The bíogo.nn Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mnist
+
+import (
+	"bytes"
+	"math"
+	"testing"
+)
+
+func TestNearestNeighbor(t *testing.T) {
+	for _, i := range []int{0, 1, 100, Test.Len() - 1} {
+		_, query := Test.Index(i)
+		got, dist, err := Test.NearestNeighbor(query)
+		if err != nil {
+			t.Errorf("Unexpected error for image %d: %v", i, err)
+			continue
+		}
+		if dist != 0 {
+			t.Errorf("Unexpected distance for image %d: got: %v want: 0", i, dist)
+		}
+		// Exact duplicates may exist, so check the content.
+		_, img := Test.Index(got)
+		if !bytes.Equal(img, query) {
+			t.Errorf("Unexpected nearest neighbor for image %d: got: %d", i, got)
+		}
+	}
+
+	_, _, err := Test.NearestNeighbor(make([]byte, 10))
+	if err == nil {
+		t.Error("Expected error for invalid query length")
+	}
+}
+
+func TestKNearestNeighbors(t *testing.T) {
+	for _, i := range []int{0, 1, 100, Test.Len() - 1} {
+		_, query := Test.Index(i)
+		nn, nnDist, err := Test.NearestNeighbor(query)
+		if err != nil {
+			t.Fatalf("Unexpected error for image %d: %v", i, err)
+		}
+		idx, dist, err := Test.KNearestNeighbors(query, 1)
+		if err != nil {
+			t.Fatalf("Unexpected error for image %d: %v", i, err)
+		}
+		if len(idx) != 1 || idx[0] != nn || dist[0] != nnDist {
+			t.Errorf("Mismatch with NearestNeighbor for image %d: got: %v %v want: [%d] [%v]", i, idx, dist, nn, nnDist)
+		}
+
+		const k = 10
+		idx, dist, err = Test.KNearestNeighbors(query, k)
+		if err != nil {
+			t.Fatalf("Unexpected error for image %d: %v", i, err)
+		}
+		if len(idx) != k || len(dist) != k {
+			t.Fatalf("Unexpected number of neighbors for image %d: got: %d want: %d", i, len(idx), k)
+		}
+		for j := 1; j < k; j++ {
+			if dist[j] < dist[j-1] {
+				t.Errorf("Neighbors not in ascending distance order for image %d: %v", i, dist)
+				break
+			}
+		}
+		for j, n := range idx {
+			_, img := Test.Index(n)
+			if want := math.Sqrt(float64(sqDist(query, img))); dist[j] != want {
+				t.Errorf("Unexpected distance for neighbor %d of image %d: got: %v want: %v", n, i, dist[j], want)
+			}
+		}
+	}
+
+	idx, _, err := Test.KNearestNeighbors(make([]byte, Test.Rows()*Test.Cols()), Test.Len()+10)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if len(idx) != Test.Len() {
+		t.Errorf("Unexpected number of neighbors for large k: got: %d want: %d", len(idx), Test.Len())
+	}
+}
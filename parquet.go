@@ -0,0 +1,102 @@
+// Copyright ©2026 The bíogo.nn Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+//go:build parquet
+
+package mnist
+
+import (
+	"os"
+
+	"github.com/apache/arrow/go/v14/parquet"
+	"github.com/apache/arrow/go/v14/parquet/file"
+	"github.com/apache/arrow/go/v14/parquet/schema"
+)
+
+// parquetRowGroupLen is the number of samples written in each
+// row group by WriteParquet.
+const parquetRowGroupLen = 10000
+
+// parquetSchema returns the schema of the files written by WriteParquet.
+func parquetSchema() (*schema.GroupNode, error) {
+	label, err := schema.NewPrimitiveNodeLogical("label", parquet.Repetitions.Required,
+		schema.NewIntLogicalType(8, true), parquet.Types.Int32, 0, -1)
+	if err != nil {
+		return nil, err
+	}
+	pixels := schema.NewByteArrayNode("pixels", parquet.Repetitions.Required, -1)
+	return schema.NewGroupNode("mnist", parquet.Repetitions.Required, schema.FieldList{label, pixels}, -1)
+}
+
+// WriteParquet writes the data set to the file at path in Apache Parquet
+// format. Each row holds one sample, with the columns "label", an INT8
+// holding the label, and "pixels", a BINARY holding the rows*cols pixels
+// of the image in row-major order.
+//
+// WriteParquet is only available when the package is built with the parquet
+// build tag, which avoids linking the Arrow Parquet implementation into
+// programs that do not use it.
+func (s *Set) WriteParquet(path string) error {
+	root, err := parquetSchema()
+	if err != nil {
+		return err
+	}
+	f, err := os.Create(path)
+	if err != nil {
+		return err
+	}
+	w := file.NewParquetWriter(f, root)
+	err = s.writeParquetRows(w)
+	if err != nil {
+		w.Close()
+		return err
+	}
+	// Closing the parquet writer closes f.
+	return w.Close()
+}
+
+func (s *Set) writeParquetRows(w *file.Writer) error {
+	labels := make([]int32, 0, parquetRowGroupLen)
+	pixels := make([]parquet.ByteArray, 0, parquetRowGroupLen)
+	for start := 0; start < s.Len(); start += parquetRowGroupLen {
+		end := min(start+parquetRowGroupLen, s.Len())
+		labels, pixels = labels[:0], pixels[:0]
+		for i := start; i < end; i++ {
+			label, img := s.Index(i)
+			labels = append(labels, int32(label))
+			pixels = append(pixels, img)
+		}
+
+		rg := w.AppendRowGroup()
+		cw, err := rg.NextColumn()
+		if err != nil {
+			return err
+		}
+		_, err = cw.(*file.Int32ColumnChunkWriter).WriteBatch(labels, nil, nil)
+		if err != nil {
+			return err
+		}
+		err = cw.Close()
+		if err != nil {
+			return err
+		}
+		cw, err = rg.NextColumn()
+		if err != nil {
+			return err
+		}
+		_, err = cw.(*file.ByteArrayColumnChunkWriter).WriteBatch(pixels, nil, nil)
+		if err != nil {
+			return err
+		}
+		err = cw.Close()
+		if err != nil {
+			return err
+		}
+		err = rg.Close()
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
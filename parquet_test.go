@@ -0,0 +1,89 @@
+// Copyright ©2026 The bíogo.nn Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+//go:build parquet
+
+package mnist
+
+import (
+	"bytes"
+	"path/filepath"
+	"testing"
+
+	"github.com/apache/arrow/go/v14/parquet"
+	"github.com/apache/arrow/go/v14/parquet/file"
+	"github.com/apache/arrow/go/v14/parquet/schema"
+)
+
+func TestWriteParquet(t *testing.T) {
+	for _, s := range []struct {
+		name string
+		set  *Set
+	}{
+		{name: "Train", set: &Train},
+		{name: "Test", set: &Test},
+	} {
+		path := filepath.Join(t.TempDir(), "mnist.parquet")
+		err := s.set.WriteParquet(path)
+		if err != nil {
+			t.Fatalf("Unexpected error writing %q: %v", s.name, err)
+		}
+
+		r, err := file.OpenParquetFile(path, false)
+		if err != nil {
+			t.Fatalf("Unexpected error opening %q: %v", s.name, err)
+		}
+		if r.NumRows() != int64(s.set.Len()) {
+			t.Errorf("Unexpected number of rows for %q: got: %d want: %d", s.name, r.NumRows(), s.set.Len())
+		}
+
+		sc := r.MetaData().Schema
+		if sc.NumColumns() != 2 {
+			t.Fatalf("Unexpected number of columns for %q: got: %d want: 2", s.name, sc.NumColumns())
+		}
+		label, pixels := sc.Column(0), sc.Column(1)
+		if label.Name() != "label" || label.PhysicalType() != parquet.Types.Int32 ||
+			!label.LogicalType().Equals(schema.NewIntLogicalType(8, true)) {
+			t.Errorf("Unexpected label column for %q: %s %s %s", s.name, label.Name(), label.PhysicalType(), label.LogicalType())
+		}
+		if pixels.Name() != "pixels" || pixels.PhysicalType() != parquet.Types.ByteArray {
+			t.Errorf("Unexpected pixels column for %q: %s %s", s.name, pixels.Name(), pixels.PhysicalType())
+		}
+
+		var i int
+		for g := 0; g < r.NumRowGroups(); g++ {
+			rg := r.RowGroup(g)
+			n := rg.NumRows()
+			cr, err := rg.Column(0)
+			if err != nil {
+				t.Fatalf("Unexpected error reading label column for %q: %v", s.name, err)
+			}
+			labels := make([]int32, n)
+			_, _, err = cr.(*file.Int32ColumnChunkReader).ReadBatch(n, labels, nil, nil)
+			if err != nil {
+				t.Fatalf("Unexpected error reading labels for %q: %v", s.name, err)
+			}
+			cr, err = rg.Column(1)
+			if err != nil {
+				t.Fatalf("Unexpected error reading pixels column for %q: %v", s.name, err)
+			}
+			images := make([]parquet.ByteArray, n)
+			_, _, err = cr.(*file.ByteArrayColumnChunkReader).ReadBatch(n, images, nil, nil)
+			if err != nil {
+				t.Fatalf("Unexpected error reading pixels for %q: %v", s.name, err)
+			}
+			for k := range labels {
+				wantLabel, wantImage := s.set.Index(i)
+				if labels[k] != int32(wantLabel) || !bytes.Equal(images[k], wantImage) {
+					t.Fatalf("Unexpected sample %d for %q", i, s.name)
+				}
+				i++
+			}
+		}
+		if i != s.set.Len() {
+			t.Errorf("Unexpected number of samples read for %q: got: %d want: %d", s.name, i, s.set.Len())
+		}
+		r.Close()
+	}
+}
@@ -0,0 +1,122 @@
+// Copyright ©2026 The bíogo.nn Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mnist
+
+import "errors"
+
+// Patches returns a new Set holding the non-overlapping patchSize×patchSize
+// patches of each image of the data set, in row-major order of patches for
+// each image. Each image produces (rows/patchSize)*(cols/patchSize) patches,
+// with any remaining pixels at the right and bottom edges discarded, and
+// each patch has the label of the image it was taken from.
+func (s *Set) Patches(patchSize int) (Set, error) {
+	if patchSize < 1 {
+		return Set{}, errors.New("mnist: patch size must be positive")
+	}
+	rows, cols := s.Dimensions()
+	if patchSize > rows || patchSize > cols {
+		return Set{}, errors.New("mnist: patch size larger than image")
+	}
+	return s.windows(patchSize, patchSize, offsets(rows, patchSize, patchSize), offsets(cols, patchSize, patchSize)), nil
+}
+
+// SlidingWindow returns a new Set holding the overlapping winH×winW windows of
+// each image of the data set taken every strideH rows and strideW columns, in
+// row-major order of windows for each image. When the strides do not evenly
+// cover an image, a final window is aligned with the bottom or right edge so
+// that every pixel is included. Each image produces
+// ceil((rows-winH)/strideH+1)*ceil((cols-winW)/strideW+1) windows, and each
+// window has the label of the image it was taken from.
+func (s *Set) SlidingWindow(winH, winW, strideH, strideW int) (Set, error) {
+	if winH < 1 || winW < 1 || strideH < 1 || strideW < 1 {
+		return Set{}, errors.New("mnist: window size and stride must be positive")
+	}
+	rows, cols := s.Dimensions()
+	if winH > rows || winW > cols {
+		return Set{}, errors.New("mnist: window larger than image")
+	}
+	return s.windows(winH, winW, edgeOffsets(rows, winH, strideH), edgeOffsets(cols, winW, strideW)), nil
+}
+
+// edgeOffsets returns the positions of windows of length win within n
+// pixels, stride pixels apart, with a final window aligned to the edge
+// if the last window does not reach it.
+func edgeOffsets(n, win, stride int) []int {
+	o := offsets(n, win, stride)
+	if last := o[len(o)-1]; last+win < n {
+		o = append(o, n-win)
+	}
+	return o
+}
+
+// offsets returns the positions of windows of length win that
+// fit within n pixels, stride pixels apart.
+func offsets(n, win, stride int) []int {
+	var o []int
+	for p := 0; p+win <= n; p += stride {
+		o = append(o, p)
+	}
+	return o
+}
+
+// windows returns a new Set holding the winH×winW windows of each image
+// of the data set at each combination of the given row and column offsets.
+func (s *Set) windows(winH, winW int, ys, xs []int) Set {
+	cols := s.Cols()
+	n := s.Len() * len(ys) * len(xs)
+	w := Set{
+		count:  int32(n),
+		rows:   int32(winH),
+		cols:   int32(winW),
+		matrix: make([]byte, 0, n*winH*winW),
+		labels: make([]byte, 0, n),
+	}
+	for i := 0; i < s.Len(); i++ {
+		label, img := s.Index(i)
+		for _, y := range ys {
+			for _, x := range xs {
+				for r := y; r < y+winH; r++ {
+					w.matrix = append(w.matrix, img[r*cols+x:r*cols+x+winW]...)
+				}
+				w.labels = append(w.labels, label)
+			}
+		}
+	}
+	return w
+}
+
+// TokenizePatches returns, for each image of the data set, a sequence of
+// (rows/patchSize)*(cols/patchSize) tokens, one for each non-overlapping
+// patchSize×patchSize patch in the order used by Patches. Each token is the
+// mean pixel value of its patch rounded to the nearest integer, so tokens
+// are in [0,255]. TokenizePatches panics if patchSize is not positive or
+// is larger than the image.
+func (s *Set) TokenizePatches(patchSize int) [][]int {
+	rows, cols := s.Dimensions()
+	if patchSize < 1 || patchSize > rows || patchSize > cols {
+		panic("mnist: invalid patch size")
+	}
+	ys := offsets(rows, patchSize, patchSize)
+	xs := offsets(cols, patchSize, patchSize)
+	area := patchSize * patchSize
+	tokens := make([][]int, s.Len())
+	for i := range tokens {
+		_, img := s.Index(i)
+		seq := make([]int, 0, len(ys)*len(xs))
+		for _, y := range ys {
+			for _, x := range xs {
+				var sum int
+				for r := y; r < y+patchSize; r++ {
+					for _, v := range img[r*cols+x : r*cols+x+patchSize] {
+						sum += int(v)
+					}
+				}
+				seq = append(seq, (sum+area/2)/area)
+			}
+		}
+		tokens[i] = seq
+	}
+	return tokens
+}
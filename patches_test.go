@@ -0,0 +1,152 @@
+// Copyright ©2026 The bíogo.nn Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mnist
+
+import (
+	"bytes"
+	"testing"
+)
+
+func TestPatches(t *testing.T) {
+	for _, s := range []struct {
+		name string
+		set  *Set
+	}{
+		{name: "Train", set: &Train},
+		{name: "Test", set: &Test},
+	} {
+		p, err := s.set.Patches(14)
+		if err != nil {
+			t.Fatalf("Unexpected error for %q: %v", s.name, err)
+		}
+		if p.Len() != 4*s.set.Len() {
+			t.Errorf("Unexpected number of patches for %q: got: %d want: %d", s.name, p.Len(), 4*s.set.Len())
+		}
+		if p.Rows() != 14 || p.Cols() != 14 {
+			t.Errorf("Unexpected patch dimensions for %q: got: %d×%d want: 14×14", s.name, p.Rows(), p.Cols())
+		}
+		for i := 0; i < s.set.Len(); i += 101 {
+			label, img := s.set.Index(i)
+			for k := 0; k < 4; k++ {
+				pl, patch := p.Index(4*i + k)
+				if pl != label {
+					t.Fatalf("Unexpected label for %q patch %d of image %d: got: %d want: %d", s.name, k, i, pl, label)
+				}
+				y0, x0 := 14*(k/2), 14*(k%2)
+				for y := 0; y < 14; y++ {
+					want := img[(y0+y)*28+x0 : (y0+y)*28+x0+14]
+					if !bytes.Equal(patch[y*14:(y+1)*14], want) {
+						t.Fatalf("Unexpected pixels for %q patch %d of image %d row %d", s.name, k, i, y)
+					}
+				}
+			}
+		}
+	}
+
+	for _, size := range []int{0, 29} {
+		_, err := Test.Patches(size)
+		if err == nil {
+			t.Errorf("Expected error for patch size %d", size)
+		}
+	}
+}
+
+var slidingWindowTests = []struct {
+	winH, winW       int
+	strideH, strideW int
+	wantPer          int
+}{
+	{winH: 5, winW: 5, strideH: 1, strideW: 1, wantPer: 24 * 24},
+	{winH: 14, winW: 14, strideH: 14, strideW: 14, wantPer: 4},
+	{winH: 10, winW: 8, strideH: 4, strideW: 7, wantPer: 6 * 4}, // ceil(18/4+1)=6, ceil(20/7+1)=4
+	{winH: 28, winW: 28, strideH: 3, strideW: 3, wantPer: 1},
+	{winH: 3, winW: 28, strideH: 5, strideW: 1, wantPer: 6},
+}
+
+func TestSlidingWindow(t *testing.T) {
+	s := subset(Test, 50)
+	rows, cols := s.Dimensions()
+	for _, test := range slidingWindowTests {
+		w, err := s.SlidingWindow(test.winH, test.winW, test.strideH, test.strideW)
+		if err != nil {
+			t.Fatalf("Unexpected error for %+v: %v", test, err)
+		}
+		if w.Len() != test.wantPer*s.Len() {
+			t.Errorf("Unexpected number of windows for %+v: got: %d want: %d", test, w.Len(), test.wantPer*s.Len())
+		}
+		if w.Rows() != test.winH || w.Cols() != test.winW {
+			t.Errorf("Unexpected window dimensions for %+v: got: %d×%d", test, w.Rows(), w.Cols())
+		}
+		for i := 0; i < s.Len(); i++ {
+			label, img := s.Index(i)
+			for _, pos := range []struct {
+				k, y, x int
+			}{
+				{k: 0, y: 0, x: 0},
+				{k: test.wantPer - 1, y: rows - test.winH, x: cols - test.winW},
+			} {
+				l, win := w.Index(i*test.wantPer + pos.k)
+				if l != label {
+					t.Fatalf("Unexpected label for %+v window %d of image %d: got: %d want: %d", test, pos.k, i, l, label)
+				}
+				for y := 0; y < test.winH; y++ {
+					want := img[(pos.y+y)*cols+pos.x : (pos.y+y)*cols+pos.x+test.winW]
+					if !bytes.Equal(win[y*test.winW:(y+1)*test.winW], want) {
+						t.Fatalf("Unexpected pixels for %+v window %d of image %d row %d", test, pos.k, i, y)
+					}
+				}
+			}
+		}
+	}
+
+	for _, bad := range [][4]int{{29, 5, 1, 1}, {5, 29, 1, 1}, {5, 5, 0, 1}, {0, 5, 1, 1}} {
+		_, err := s.SlidingWindow(bad[0], bad[1], bad[2], bad[3])
+		if err == nil {
+			t.Errorf("Expected error for window %v", bad)
+		}
+	}
+}
+
+func TestTokenizePatches(t *testing.T) {
+	for _, size := range []int{4, 7, 10, 14} {
+		tokens := Test.TokenizePatches(size)
+		if len(tokens) != Test.Len() {
+			t.Fatalf("Unexpected number of sequences for size %d: got: %d want: %d", size, len(tokens), Test.Len())
+		}
+		want := (28 / size) * (28 / size)
+		for i, seq := range tokens {
+			if len(seq) != want {
+				t.Fatalf("Unexpected number of tokens for size %d image %d: got: %d want: %d", size, i, len(seq), want)
+			}
+		}
+	}
+
+	// A constant valued image produces the same token at every position.
+	for _, v := range []byte{0, 1, 77, 128, 255} {
+		s := Set{count: 1, rows: 28, cols: 28, matrix: bytes.Repeat([]byte{v}, 28*28), labels: []byte{0}}
+		for _, tok := range s.TokenizePatches(7)[0] {
+			if tok != int(v) {
+				t.Errorf("Unexpected token for constant patch %d: got: %d want: %d", v, tok, v)
+			}
+		}
+	}
+
+	s := drawSet(
+		"##..",
+		"##..",
+		"#...",
+		"....",
+	)
+	want := []int{255, 0, 64, 0}
+	got := s.TokenizePatches(2)[0]
+	if len(got) != len(want) {
+		t.Fatalf("Unexpected number of tokens: got: %d want: %d", len(got), len(want))
+	}
+	for k := range want {
+		if got[k] != want[k] {
+			t.Errorf("Unexpected token %d: got: %d want: %d", k, got[k], want[k])
+		}
+	}
+}
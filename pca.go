@@ -0,0 +1,120 @@
+// Copyright ©2026 The bíogo.nn Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mnist
+
+import (
+	"errors"
+	"fmt"
+
+	"gonum.org/v1/gonum/mat"
+)
+
+// Covariance returns the sample covariance matrix of the image pixels of the
+// data set. The returned matrix has rows*cols rows and columns.
+func (s *Set) Covariance() (*mat.SymDense, error) {
+	n := s.Len()
+	if n == 0 {
+		return nil, errors.New("mnist: no samples")
+	}
+	dim := s.Rows() * s.Cols()
+
+	mean := s.pixelMeans()
+
+	// Accumulate the centred outer products in blocks to
+	// make use of the level 3 BLAS routines.
+	const blockSize = 1024
+	cov := mat.NewSymDense(dim, nil)
+	block := mat.NewDense(min(blockSize, n), dim, nil)
+	for from := 0; from < n; from += blockSize {
+		to := min(from+blockSize, n)
+		b := block.Slice(0, to-from, 0, dim).(*mat.Dense)
+		for i := from; i < to; i++ {
+			_, img := s.Index(i)
+			row := b.RawRowView(i - from)
+			for j, v := range img {
+				row[j] = float64(v) - mean[j]
+			}
+		}
+		cov.SymRankK(cov, 1, b.T())
+	}
+	cov.ScaleSym(1/float64(max(n-1, 1)), cov)
+
+	return cov, nil
+}
+
+// PCAProject returns the projection of the images of the data set onto their
+// first components principal axes, and the projection matrix used. Pixel values
+// are scaled to [0,1] before projection and are not centred, so a vector x of
+// scaled pixel values is projected by x·P where P is the returned matrix with
+// rows*cols rows and components columns.
+func (s *Set) PCAProject(components int) (FloatSet, *mat.Dense, error) {
+	dim := s.Rows() * s.Cols()
+	if components < 1 || components > dim {
+		return FloatSet{}, nil, fmt.Errorf("mnist: invalid number of components: %d", components)
+	}
+	cov, err := s.Covariance()
+	if err != nil {
+		return FloatSet{}, nil, err
+	}
+	var eig mat.EigenSym
+	ok := eig.Factorize(cov, true)
+	if !ok {
+		return FloatSet{}, nil, errors.New("mnist: eigendecomposition failed")
+	}
+	var vecs mat.Dense
+	eig.VectorsTo(&vecs)
+
+	// Eigenvalues are in ascending order, so take the
+	// last components columns in reverse order.
+	proj := mat.NewDense(dim, components, nil)
+	for j := 0; j < components; j++ {
+		col := mat.Col(nil, dim-1-j, &vecs)
+		proj.SetCol(j, col)
+	}
+
+	n := s.Len()
+	fs := newFloatSet(n, components, s.labels)
+	const blockSize = 1024
+	var block, out mat.Dense
+	for from := 0; from < n; from += blockSize {
+		to := min(from+blockSize, n)
+		block.ReuseAs(to-from, dim)
+		for i := from; i < to; i++ {
+			_, img := s.Index(i)
+			row := block.RawRowView(i - from)
+			for j, v := range img {
+				row[j] = float64(v) / 255
+			}
+		}
+		out.ReuseAs(to-from, components)
+		out.Mul(&block, proj)
+		for i := from; i < to; i++ {
+			_, vec := fs.Index(i)
+			for j, v := range out.RawRowView(i - from) {
+				vec[j] = float32(v)
+			}
+		}
+		block.Reset()
+		out.Reset()
+	}
+
+	return fs, proj, nil
+}
+
+// pixelMeans returns the mean value of each pixel position over the
+// images of the data set.
+func (s *Set) pixelMeans() []float64 {
+	mean := make([]float64, s.Rows()*s.Cols())
+	for i := 0; i < s.Len(); i++ {
+		_, img := s.Index(i)
+		for j, v := range img {
+			mean[j] += float64(v)
+		}
+	}
+	for j := range mean {
+		mean[j] /= float64(s.Len())
+	}
+	return mean
+}
@@ -0,0 +1,105 @@
+// Copyright ©2026 The bíogo.nn Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mnist
+
+import (
+	"math"
+	"testing"
+
+	"gonum.org/v1/gonum/mat"
+)
+
+func TestCovariance(t *testing.T) {
+	c, err := Test.Covariance()
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	dim := Test.Rows() * Test.Cols()
+	r, cols := c.Dims()
+	if r != dim || cols != dim {
+		t.Fatalf("Unexpected dimensions: got: %d×%d want: %d×%d", r, cols, dim, dim)
+	}
+	for i := 0; i < dim; i++ {
+		for j := i + 1; j < dim; j++ {
+			if c.At(i, j) != c.At(j, i) {
+				t.Fatalf("Covariance matrix not symmetric at (%d,%d): %v != %v", i, j, c.At(i, j), c.At(j, i))
+			}
+		}
+	}
+
+	// Check the diagonal against directly computed pixel variances.
+	mean := Test.pixelMeans()
+	for _, p := range []int{0, 100, 392, 783} {
+		var v float64
+		for i := 0; i < Test.Len(); i++ {
+			_, img := Test.Index(i)
+			d := float64(img[p]) - mean[p]
+			v += d * d
+		}
+		v /= float64(Test.Len() - 1)
+		if math.Abs(c.At(p, p)-v) > 1e-6*math.Max(1, v) {
+			t.Errorf("Unexpected variance for pixel %d: got: %v want: %v", p, c.At(p, p), v)
+		}
+	}
+
+	_, err = (&Set{}).Covariance()
+	if err == nil {
+		t.Error("Expected error for empty set")
+	}
+}
+
+func TestPCAProject(t *testing.T) {
+	fs, proj, err := Test.PCAProject(Test.Rows() * Test.Cols())
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	dim := Test.Rows() * Test.Cols()
+	if fs.Len() != Test.Len() || fs.Dim() != dim {
+		t.Fatalf("Unexpected projected shape: got: %d×%d want: %d×%d", fs.Len(), fs.Dim(), Test.Len(), dim)
+	}
+	r, c := proj.Dims()
+	if r != dim || c != dim {
+		t.Fatalf("Unexpected projection matrix shape: got: %d×%d want: %d×%d", r, c, dim, dim)
+	}
+
+	// With all components retained the projection is
+	// invertible, so the reconstruction loss is zero.
+	for _, i := range []int{0, 1, 100, Test.Len() - 1} {
+		label, vec := fs.Index(i)
+		wantLabel, img := Test.Index(i)
+		if label != wantLabel {
+			t.Errorf("Unexpected label for image %d: got: %d want: %d", i, label, wantLabel)
+		}
+		f := make([]float64, len(vec))
+		for j, v := range vec {
+			f[j] = float64(v)
+		}
+		var rec mat.VecDense
+		rec.MulVec(proj, mat.NewVecDense(len(f), f))
+		for j, v := range img {
+			if math.Abs(rec.AtVec(j)-float64(v)/255) > 1e-4 {
+				t.Errorf("Unexpected reconstruction for image %d pixel %d: got: %v want: %v", i, j, rec.AtVec(j), float64(v)/255)
+				break
+			}
+		}
+	}
+
+	fs, proj, err = Test.PCAProject(10)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if fs.Dim() != 10 {
+		t.Errorf("Unexpected projected dimension: got: %d want: 10", fs.Dim())
+	}
+	_, c = proj.Dims()
+	if c != 10 {
+		t.Errorf("Unexpected projection matrix columns: got: %d want: 10", c)
+	}
+
+	_, _, err = Test.PCAProject(dim + 1)
+	if err == nil {
+		t.Error("Expected error for too many components")
+	}
+}
@@ -0,0 +1,124 @@
+// Copyright ©2026 The bíogo.nn Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mnist
+
+import (
+	"fmt"
+	"math/rand"
+)
+
+// A Stage is a transformation of a labelled image.
+type Stage interface {
+	// Apply returns the transformed label and image. The image
+	// has the given dimensions and may be modified in place and
+	// returned. The returned image must have the same dimensions.
+	Apply(label byte, image []byte, rows, cols int) (byte, []byte)
+}
+
+// StageFunc is an adapter to allow the use of an ordinary function as a Stage.
+type StageFunc func(label byte, image []byte, rows, cols int) (byte, []byte)
+
+// Apply returns f(label, image, rows, cols).
+func (f StageFunc) Apply(label byte, image []byte, rows, cols int) (byte, []byte) {
+	return f(label, image, rows, cols)
+}
+
+// A RandomStage is a Stage whose transformation depends on random decisions.
+// When applied by Augment, ApplyRand is called instead of Apply with the
+// source of randomness passed to Augment.
+type RandomStage interface {
+	Stage
+
+	// ApplyRand is as for Apply, but uses r for random decisions.
+	ApplyRand(label byte, image []byte, rows, cols int, r *rand.Rand) (byte, []byte)
+}
+
+// applyRand applies st to the labelled image, using ApplyRand with r if
+// st is a RandomStage.
+func applyRand(st Stage, label byte, image []byte, rows, cols int, r *rand.Rand) (byte, []byte) {
+	if rs, ok := st.(RandomStage); ok {
+		return rs.ApplyRand(label, image, rows, cols, r)
+	}
+	return st.Apply(label, image, rows, cols)
+}
+
+// Identity returns a Stage that returns its input unaltered.
+func Identity() Stage {
+	return StageFunc(func(label byte, image []byte, _, _ int) (byte, []byte) {
+		return label, image
+	})
+}
+
+// Compose returns a Stage that applies each of the stages in order.
+func Compose(stages ...Stage) Stage {
+	stages = append([]Stage(nil), stages...)
+	return StageFunc(func(label byte, image []byte, rows, cols int) (byte, []byte) {
+		for _, st := range stages {
+			label, image = st.Apply(label, image, rows, cols)
+		}
+		return label, image
+	})
+}
+
+// Pipe returns a new Set holding the result of applying the stages in order to
+// each labelled image of the data set. The stages are applied in a single pass
+// to a copy of each image, so the data set is not altered.
+func (s *Set) Pipe(stages ...Stage) Set {
+	st := Compose(stages...)
+	c := *s
+	c.matrix = make([]byte, len(s.matrix))
+	c.labels = make([]byte, len(s.labels))
+	rows, cols := s.Rows(), s.Cols()
+	stride := rows * cols
+	for i := 0; i < s.Len(); i++ {
+		label, img := s.Index(i)
+		dst := c.matrix[i*stride : (i+1)*stride : (i+1)*stride]
+		copy(dst, img)
+		label, img = st.Apply(label, dst, rows, cols)
+		if len(img) != stride {
+			panic(fmt.Sprintf("mnist: stage returned image with length %d, want %d", len(img), stride))
+		}
+		copy(dst, img)
+		c.labels[i] = label
+	}
+	return c
+}
+
+// Augment returns a new Set of s.Len()*factor samples. The first s.Len() samples
+// are the unaltered samples of the data set, followed by factor-1 augmented
+// copies of the data set, each produced by applying transforms in order to a
+// copy of every sample. Stages that implement RandomStage are applied with r,
+// so each augmented copy has fresh random decisions. Augment panics if factor
+// is less than one.
+func (s *Set) Augment(transforms []Stage, factor int, r *rand.Rand) Set {
+	if factor < 1 {
+		panic("mnist: augmentation factor must be positive")
+	}
+	c := *s
+	c.count = s.count * int32(factor)
+	c.matrix = make([]byte, 0, len(s.matrix)*factor)
+	c.labels = make([]byte, 0, len(s.labels)*factor)
+	c.matrix = append(c.matrix, s.matrix...)
+	c.labels = append(c.labels, s.labels...)
+	rows, cols := s.Rows(), s.Cols()
+	stride := rows * cols
+	for k := 1; k < factor; k++ {
+		for i := 0; i < s.Len(); i++ {
+			label, img := s.Index(i)
+			start := len(c.matrix)
+			c.matrix = append(c.matrix, img...)
+			dst := c.matrix[start : start+stride : start+stride]
+			for _, st := range transforms {
+				label, img = applyRand(st, label, dst, rows, cols, r)
+				if len(img) != stride {
+					panic(fmt.Sprintf("mnist: stage returned image with length %d, want %d", len(img), stride))
+				}
+				copy(dst, img)
+			}
+			c.labels = append(c.labels, label)
+		}
+	}
+	return c
+}
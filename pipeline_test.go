@@ -0,0 +1,95 @@
+// Copyright ©2026 The bíogo.nn Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mnist
+
+import (
+	"bytes"
+	"math/rand"
+	"testing"
+)
+
+func TestPipe(t *testing.T) {
+	got := Test.Pipe(Identity(), Identity())
+	if got.count != Test.count || got.rows != Test.rows || got.cols != Test.cols {
+		t.Errorf("Unexpected shape: got: %d×%d×%d want: %d×%d×%d",
+			got.count, got.rows, got.cols, Test.count, Test.rows, Test.cols)
+	}
+	if !bytes.Equal(got.matrix, Test.matrix) || !bytes.Equal(got.labels, Test.labels) {
+		t.Error("Identity pipeline altered data")
+	}
+
+	invert := StageFunc(func(label byte, image []byte, _, _ int) (byte, []byte) {
+		for i, v := range image {
+			image[i] = 255 - v
+		}
+		return label, image
+	})
+	relabel := StageFunc(func(label byte, image []byte, _, _ int) (byte, []byte) {
+		return (label + 1) % 10, image
+	})
+	orig := Test.Images()
+	got = Test.Pipe(Compose(invert, relabel), invert)
+	if !bytes.Equal(Test.matrix, orig) {
+		t.Error("Pipe altered source data")
+	}
+	if !bytes.Equal(got.matrix, Test.matrix) {
+		t.Error("Double inversion did not restore images")
+	}
+	for i, l := range got.labels {
+		if l != (Test.labels[i]+1)%10 {
+			t.Errorf("Unexpected label at %d: got: %d want: %d", i, l, (Test.labels[i]+1)%10)
+			break
+		}
+	}
+}
+
+type randomInvert struct{}
+
+func (randomInvert) Apply(label byte, image []byte, _, _ int) (byte, []byte) {
+	return label, image
+}
+
+func (randomInvert) ApplyRand(label byte, image []byte, _, _ int, r *rand.Rand) (byte, []byte) {
+	if r.Intn(2) == 0 {
+		return label, image
+	}
+	for i, v := range image {
+		image[i] = 255 - v
+	}
+	return label, image
+}
+
+func TestAugment(t *testing.T) {
+	s := subset(Test, 50)
+	orig := s.Images()
+	for _, factor := range []int{1, 2, 4} {
+		got := s.Augment([]Stage{randomInvert{}}, factor, rand.New(rand.NewSource(1)))
+		if got.Len() != s.Len()*factor {
+			t.Errorf("Unexpected length for factor %d: got: %d want: %d", factor, got.Len(), s.Len()*factor)
+			continue
+		}
+		if !bytes.Equal(s.matrix, orig) {
+			t.Errorf("Augment altered source data for factor %d", factor)
+		}
+		n := len(s.matrix)
+		if !bytes.Equal(got.matrix[:n], s.matrix) || !bytes.Equal(got.labels[:s.Len()], s.labels) {
+			t.Errorf("Originals not preserved for factor %d", factor)
+		}
+		var inverted int
+		for i := s.Len(); i < got.Len(); i++ {
+			label, img := got.Index(i)
+			wantLabel, src := s.Index(i % s.Len())
+			if label != wantLabel {
+				t.Errorf("Unexpected label at %d for factor %d: got: %d want: %d", i, factor, label, wantLabel)
+			}
+			if !bytes.Equal(img, src) {
+				inverted++
+			}
+		}
+		if factor > 1 && (inverted == 0 || inverted == got.Len()-s.Len()) {
+			t.Errorf("Unexpected number of augmented images for factor %d: %d of %d", factor, inverted, got.Len()-s.Len())
+		}
+	}
+}
@@ -0,0 +1,188 @@
+// Copyright ©2026 The bíogo.nn Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mnist
+
+import (
+	"errors"
+	"fmt"
+	"image"
+	"image/color"
+	"image/png"
+	"io"
+	"os"
+	"path/filepath"
+	"runtime"
+	"strings"
+	"sync"
+)
+
+// WritePNG writes the i'th image of the data set to w as a grayscale PNG.
+func (s *Set) WritePNG(i int, w io.Writer) error {
+	return png.Encode(w, s.gray(i))
+}
+
+// gray returns the i'th image of the data set as an *image.Gray sharing
+// the pixel data of the set.
+func (s *Set) gray(i int) *image.Gray {
+	_, img := s.Index(i)
+	return &image.Gray{
+		Pix:    img,
+		Stride: s.Cols(),
+		Rect:   image.Rect(0, 0, s.Cols(), s.Rows()),
+	}
+}
+
+// WriteGrid writes a PNG contact sheet of the first rows*cols images in the data set to w.
+// Images are laid out row-wise and separated by borders of borderWidth pixels with the
+// value borderValue.
+func (s *Set) WriteGrid(w io.Writer, rows, cols int, borderWidth int, borderValue byte) error {
+	if rows <= 0 || cols <= 0 {
+		return errors.New("mnist: invalid grid dimensions")
+	}
+	if borderWidth < 0 {
+		return errors.New("mnist: negative border width")
+	}
+	if rows*cols > s.Len() {
+		return errors.New("mnist: grid larger than data set")
+	}
+
+	r, c := s.Rows(), s.Cols()
+	width := cols*c + (cols-1)*borderWidth
+	height := rows*r + (rows-1)*borderWidth
+	grid := image.NewGray(image.Rect(0, 0, width, height))
+	for i := range grid.Pix {
+		grid.Pix[i] = borderValue
+	}
+	for i := 0; i < rows*cols; i++ {
+		_, img := s.Index(i)
+		x0 := (i % cols) * (c + borderWidth)
+		y0 := (i / cols) * (r + borderWidth)
+		for y := 0; y < r; y++ {
+			copy(grid.Pix[(y0+y)*grid.Stride+x0:], img[y*c:(y+1)*c])
+		}
+	}
+
+	return png.Encode(w, grid)
+}
+
+// WriteAllPNGs writes each image of the data set to dir as a grayscale PNG.
+// Files are named using the pattern "%06d_%d.png" with the image index and
+// label, and dir is created if it does not exist. Files are written concurrently
+// by runtime.NumCPU() workers.
+func (s *Set) WriteAllPNGs(dir string) error {
+	err := os.MkdirAll(dir, 0o755)
+	if err != nil {
+		return err
+	}
+
+	var (
+		wg   sync.WaitGroup
+		once sync.Once
+		werr error
+	)
+	work := make(chan int)
+	done := make(chan struct{})
+	for n := 0; n < runtime.NumCPU(); n++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range work {
+				err := s.writePNGFile(dir, i)
+				if err != nil {
+					once.Do(func() {
+						werr = err
+						close(done)
+					})
+				}
+			}
+		}()
+	}
+feed:
+	for i := 0; i < s.Len(); i++ {
+		select {
+		case work <- i:
+		case <-done:
+			break feed
+		}
+	}
+	close(work)
+	wg.Wait()
+
+	return werr
+}
+
+func (s *Set) writePNGFile(dir string, i int) error {
+	label, _ := s.Index(i)
+	f, err := os.Create(filepath.Join(dir, fmt.Sprintf("%06d_%d.png", i, label)))
+	if err != nil {
+		return err
+	}
+	err = s.WritePNG(i, f)
+	if err != nil {
+		f.Close()
+		return err
+	}
+	return f.Close()
+}
+
+// ReadFromPNGDirectory returns a Set constructed from the PNG files in dir.
+// Images are read in lexical file name order and converted to grayscale.
+// The label for each image is obtained by calling labelFromName with the
+// base name of the file. All images must have the same dimensions.
+func ReadFromPNGDirectory(dir string, labelFromName func(filename string) (byte, error)) (Set, error) {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return Set{}, err
+	}
+
+	var s Set
+	for _, e := range entries {
+		if e.IsDir() || !strings.EqualFold(filepath.Ext(e.Name()), ".png") {
+			continue
+		}
+		label, err := labelFromName(e.Name())
+		if err != nil {
+			return Set{}, fmt.Errorf("mnist: %s: %v", e.Name(), err)
+		}
+		img, err := readPNGFile(filepath.Join(dir, e.Name()))
+		if err != nil {
+			return Set{}, fmt.Errorf("mnist: %s: %v", e.Name(), err)
+		}
+		err = s.appendGray(label, img)
+		if err != nil {
+			return Set{}, fmt.Errorf("mnist: %s: %v", e.Name(), err)
+		}
+	}
+
+	return s, nil
+}
+
+func readPNGFile(path string) (image.Image, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	return png.Decode(f)
+}
+
+// appendGray appends img converted to grayscale to the data set with the given label.
+// The first image appended determines the dimensions of the set.
+func (s *Set) appendGray(label byte, img image.Image) error {
+	b := img.Bounds()
+	if s.count == 0 {
+		s.rows, s.cols = int32(b.Dy()), int32(b.Dx())
+	} else if b.Dy() != s.Rows() || b.Dx() != s.Cols() {
+		return fmt.Errorf("mismatched image dimensions: got: %d×%d want: %d×%d", b.Dy(), b.Dx(), s.Rows(), s.Cols())
+	}
+	for y := b.Min.Y; y < b.Max.Y; y++ {
+		for x := b.Min.X; x < b.Max.X; x++ {
+			s.matrix = append(s.matrix, color.GrayModel.Convert(img.At(x, y)).(color.Gray).Y)
+		}
+	}
+	s.labels = append(s.labels, label)
+	s.count++
+	return nil
+}
@@ -0,0 +1,170 @@
+// Copyright ©2026 The bíogo.nn Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mnist
+
+import (
+	"bytes"
+	"fmt"
+	"image"
+	"image/png"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strconv"
+	"strings"
+	"testing"
+)
+
+func TestWritePNG(t *testing.T) {
+	for _, i := range []int{0, 1, 100, Test.Len() - 1} {
+		var buf bytes.Buffer
+		err := Test.WritePNG(i, &buf)
+		if err != nil {
+			t.Errorf("Unexpected error for image %d: %v", i, err)
+			continue
+		}
+		img, err := png.Decode(&buf)
+		if err != nil {
+			t.Errorf("Unexpected error decoding image %d: %v", i, err)
+			continue
+		}
+		gray, ok := img.(*image.Gray)
+		if !ok {
+			t.Errorf("Unexpected image type for image %d: got: %T want: *image.Gray", i, img)
+			continue
+		}
+		_, want := Test.Index(i)
+		if !bytes.Equal(gray.Pix, want) {
+			t.Errorf("Pixel mismatch after round-trip for image %d", i)
+		}
+	}
+}
+
+func TestWriteGrid(t *testing.T) {
+	for _, test := range []struct {
+		rows, cols int
+		border     int
+	}{
+		{rows: 1, cols: 1, border: 0},
+		{rows: 3, cols: 4, border: 0},
+		{rows: 4, cols: 3, border: 2},
+		{rows: 10, cols: 10, border: 1},
+	} {
+		var buf bytes.Buffer
+		err := Test.WriteGrid(&buf, test.rows, test.cols, test.border, 128)
+		if err != nil {
+			t.Errorf("Unexpected error for %d×%d grid: %v", test.rows, test.cols, err)
+			continue
+		}
+		img, err := png.Decode(&buf)
+		if err != nil {
+			t.Errorf("Unexpected error decoding %d×%d grid: %v", test.rows, test.cols, err)
+			continue
+		}
+		b := img.Bounds()
+		wantWidth := test.cols*Test.Cols() + (test.cols-1)*test.border
+		wantHeight := test.rows*Test.Rows() + (test.rows-1)*test.border
+		if b.Dx() != wantWidth {
+			t.Errorf("Unexpected width for %d×%d grid: got: %d want: %d", test.rows, test.cols, b.Dx(), wantWidth)
+		}
+		if b.Dy() != wantHeight {
+			t.Errorf("Unexpected height for %d×%d grid: got: %d want: %d", test.rows, test.cols, b.Dy(), wantHeight)
+		}
+	}
+}
+
+func TestWriteAllPNGs(t *testing.T) {
+	dir := filepath.Join(t.TempDir(), "png")
+	err := Test.WriteAllPNGs(dir)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		t.Fatalf("Unexpected error reading directory: %v", err)
+	}
+	if len(entries) != Test.Len() {
+		t.Errorf("Unexpected number of files: got: %d want: %d", len(entries), Test.Len())
+	}
+	name := regexp.MustCompile(`^[0-9]{6}_[0-9]\.png$`)
+	for _, e := range entries {
+		if !name.MatchString(e.Name()) {
+			t.Errorf("Unexpected file name: %q", e.Name())
+		}
+		f, err := os.Open(filepath.Join(dir, e.Name()))
+		if err != nil {
+			t.Errorf("Unexpected error opening %q: %v", e.Name(), err)
+			continue
+		}
+		cfg, err := png.DecodeConfig(f)
+		f.Close()
+		if err != nil {
+			t.Errorf("Unexpected error decoding %q: %v", e.Name(), err)
+			continue
+		}
+		if cfg.Width != Test.Cols() || cfg.Height != Test.Rows() {
+			t.Errorf("Unexpected dimensions for %q: got: %d×%d want: %d×%d", e.Name(), cfg.Height, cfg.Width, Test.Rows(), Test.Cols())
+		}
+	}
+	for _, i := range []int{0, 1, 100, Test.Len() - 1} {
+		label, _ := Test.Index(i)
+		_, err := os.Stat(filepath.Join(dir, fmt.Sprintf("%06d_%d.png", i, label)))
+		if err != nil {
+			t.Errorf("Missing file for image %d: %v", i, err)
+		}
+	}
+
+}
+
+func TestReadFromPNGDirectory(t *testing.T) {
+	dir := t.TempDir()
+	err := Test.WriteAllPNGs(dir)
+	if err != nil {
+		t.Fatalf("Unexpected error writing images: %v", err)
+	}
+	got, err := ReadFromPNGDirectory(dir, func(name string) (byte, error) {
+		_, label, ok := strings.Cut(strings.TrimSuffix(name, ".png"), "_")
+		if !ok {
+			return 0, fmt.Errorf("invalid file name: %q", name)
+		}
+		l, err := strconv.ParseUint(label, 10, 8)
+		return byte(l), err
+	})
+	if err != nil {
+		t.Fatalf("Unexpected error reading images: %v", err)
+	}
+	if got.Len() != Test.Len() || got.Rows() != Test.Rows() || got.Cols() != Test.Cols() {
+		t.Fatalf("Unexpected shape: got: %d×%d×%d want: %d×%d×%d",
+			got.Len(), got.Rows(), got.Cols(), Test.Len(), Test.Rows(), Test.Cols())
+	}
+	if !bytes.Equal(got.labels, Test.labels) {
+		t.Errorf("Label mismatch after round-trip")
+	}
+	if !bytes.Equal(got.matrix, Test.matrix) {
+		t.Errorf("Pixel mismatch after round-trip")
+	}
+}
+
+func TestReadFromPNGDirectoryMismatch(t *testing.T) {
+	dir := t.TempDir()
+	for i, img := range []image.Image{
+		image.NewGray(image.Rect(0, 0, 28, 28)),
+		image.NewGray(image.Rect(0, 0, 14, 28)),
+	} {
+		f, err := os.Create(filepath.Join(dir, fmt.Sprintf("%06d_0.png", i)))
+		if err != nil {
+			t.Fatalf("Unexpected error creating image: %v", err)
+		}
+		err = png.Encode(f, img)
+		f.Close()
+		if err != nil {
+			t.Fatalf("Unexpected error writing image: %v", err)
+		}
+	}
+	_, err := ReadFromPNGDirectory(dir, func(string) (byte, error) { return 0, nil })
+	if err == nil {
+		t.Error("Expected error for mismatched image dimensions")
+	}
+}
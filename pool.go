@@ -0,0 +1,51 @@
+// Copyright ©2026 The bíogo.nn Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mnist
+
+import (
+	"sync"
+	"unsafe"
+)
+
+// A FloatPool is a pool of float32 image buffers that is safe for
+// concurrent use. A FloatPool must be created with Set.Pool32.
+type FloatPool struct {
+	size int
+
+	// pool holds pointers to the first element of each
+	// buffer so that Put does not need to allocate.
+	pool sync.Pool
+}
+
+// Pool32 returns a FloatPool of buffers with length s.Rows()*s.Cols(),
+// pre-populated with n buffers.
+func (s *Set) Pool32(n int) *FloatPool {
+	p := &FloatPool{size: s.Rows() * s.Cols()}
+	p.pool.New = func() any {
+		if p.size == 0 {
+			return (*float32)(nil)
+		}
+		return &make([]float32, p.size)[0]
+	}
+	for i := 0; i < n; i++ {
+		p.pool.Put(p.pool.New())
+	}
+	return p
+}
+
+// Get returns a buffer from the pool, allocating one if none is available.
+// The contents of the returned buffer are undefined.
+func (p *FloatPool) Get() []float32 {
+	return unsafe.Slice(p.pool.Get().(*float32), p.size)
+}
+
+// Put returns buf to the pool. buf must not be used after it has been
+// returned. Buffers that do not have the pool's length are discarded.
+func (p *FloatPool) Put(buf []float32) {
+	if len(buf) != p.size || p.size == 0 {
+		return
+	}
+	p.pool.Put(&buf[0])
+}
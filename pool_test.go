@@ -0,0 +1,67 @@
+// Copyright ©2026 The bíogo.nn Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mnist
+
+import (
+	"sync"
+	"testing"
+)
+
+func TestPool32(t *testing.T) {
+	p := Test.Pool32(4)
+	buf := p.Get()
+	if len(buf) != Test.Rows()*Test.Cols() {
+		t.Fatalf("Unexpected buffer length: got: %d want: %d", len(buf), Test.Rows()*Test.Cols())
+	}
+	p.Put(buf)
+
+	var i int
+	allocs := testing.AllocsPerRun(1000, func() {
+		buf := p.Get()
+		err := Test.ScanFloat32(i%Test.Len(), buf)
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		p.Put(buf)
+		i++
+	})
+	if allocs != 0 {
+		t.Errorf("Unexpected allocations per Get/ScanFloat32/Put: got: %v want: 0", allocs)
+	}
+
+	// Buffers of the wrong length are discarded.
+	p.Put(make([]float32, 10))
+	if got := p.Get(); len(got) != Test.Rows()*Test.Cols() {
+		t.Errorf("Unexpected buffer length after discarded Put: got: %d want: %d", len(got), Test.Rows()*Test.Cols())
+	}
+}
+
+func TestPool32Concurrent(t *testing.T) {
+	p := Test.Pool32(0)
+	var wg sync.WaitGroup
+	for w := 0; w < 8; w++ {
+		wg.Add(1)
+		go func(w int) {
+			defer wg.Done()
+			for i := w; i < Test.Len(); i += 8 {
+				buf := p.Get()
+				err := Test.ScanFloat32(i, buf)
+				if err != nil {
+					t.Errorf("Unexpected error: %v", err)
+					return
+				}
+				_, img := Test.Index(i)
+				for j, v := range img {
+					if buf[j] != float32(v)/255 {
+						t.Errorf("Unexpected pixel %d for image %d", j, i)
+						return
+					}
+				}
+				p.Put(buf)
+			}
+		}(w)
+	}
+	wg.Wait()
+}
@@ -0,0 +1,149 @@
+// Copyright ©2026 The bíogo.nn Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mnist
+
+import "math"
+
+// QuantileTransform returns a FloatSet where each pixel value is replaced by its
+// quantile rank in [0,1] among the values of that pixel position over the data
+// set, so that the marginal distribution of each pixel is approximately uniform.
+// The empirical distribution of each pixel position is summarised by nQuantiles
+// quantiles, which is limited to between 2 and s.Len(). Tied values are mapped
+// to the middle of the quantile range they span.
+func (s *Set) QuantileTransform(nQuantiles int) FloatSet {
+	dim := s.Rows() * s.Cols()
+	fs := newFloatSet(s.Len(), dim, s.labels)
+	if s.Len() == 0 {
+		return fs
+	}
+	nQuantiles = max(2, min(nQuantiles, s.Len()))
+
+	levels := make([]float64, nQuantiles)
+	for k := range levels {
+		levels[k] = float64(k) / float64(nQuantiles-1)
+	}
+	quantiles := make([]float64, nQuantiles)
+	var table [256]float32
+	for p := 0; p < dim; p++ {
+		h := s.pixelHistogram(p)
+		for k, l := range levels {
+			quantiles[k] = h.quantile(l)
+		}
+		for v := range table {
+			table[v] = float32((interpUp(float64(v), quantiles, levels) + interpDown(float64(v), quantiles, levels)) / 2)
+		}
+		for i := 0; i < s.Len(); i++ {
+			_, img := s.Index(i)
+			_, vec := fs.Index(i)
+			vec[p] = table[img[p]]
+		}
+	}
+	return fs
+}
+
+// robustEpsilon is added to the quantile range in RobustScale
+// to avoid division by zero for constant pixel positions.
+const robustEpsilon = 1e-6
+
+// RobustScale returns a FloatSet where each pixel value has had the median of
+// that pixel position over the data set subtracted and has been divided by the
+// range between the lowerQuantile and upperQuantile quantiles of the pixel
+// position, plus a small epsilon. With quantiles of 0.25 and 0.75 this is the
+// interquartile range. RobustScale panics if the quantiles are not in [0,1]
+// or lowerQuantile is greater than upperQuantile.
+func (s *Set) RobustScale(lowerQuantile, upperQuantile float64) FloatSet {
+	if lowerQuantile < 0 || upperQuantile > 1 || lowerQuantile > upperQuantile {
+		panic("mnist: invalid quantile range")
+	}
+	dim := s.Rows() * s.Cols()
+	fs := newFloatSet(s.Len(), dim, s.labels)
+	if s.Len() == 0 {
+		return fs
+	}
+	for p := 0; p < dim; p++ {
+		h := s.pixelHistogram(p)
+		median := h.quantile(0.5)
+		scale := h.quantile(upperQuantile) - h.quantile(lowerQuantile) + robustEpsilon
+		for i := 0; i < s.Len(); i++ {
+			_, img := s.Index(i)
+			_, vec := fs.Index(i)
+			vec[p] = float32((float64(img[p]) - median) / scale)
+		}
+	}
+	return fs
+}
+
+// pixelHistogram returns the histogram of values at pixel position p over
+// all the images of the data set.
+func (s *Set) pixelHistogram(p int) *histogram {
+	h := histogram{n: s.Len()}
+	for i := 0; i < s.Len(); i++ {
+		_, img := s.Index(i)
+		h.counts[img[p]]++
+	}
+	return &h
+}
+
+// histogram holds the counts of byte values.
+type histogram struct {
+	counts [256]int
+	n      int
+}
+
+// at returns the k'th smallest value counted by the histogram.
+func (h *histogram) at(k int) float64 {
+	for v, c := range h.counts {
+		k -= c
+		if k < 0 {
+			return float64(v)
+		}
+	}
+	return 255
+}
+
+// quantile returns the empirical quantile at level p in [0,1] of
+// the values counted by the histogram, interpolating linearly
+// between order statistics.
+func (h *histogram) quantile(p float64) float64 {
+	pos := p * float64(h.n-1)
+	lo := math.Floor(pos)
+	x := h.at(int(lo))
+	if lo == pos {
+		return x
+	}
+	return x + (pos-lo)*(h.at(int(lo)+1)-x)
+}
+
+// interpUp returns the linear interpolation of y at v over the non-decreasing
+// knots xs, taking the last knot when v equals repeated knot values.
+func interpUp(v float64, xs, ys []float64) float64 {
+	if v < xs[0] {
+		return ys[0]
+	}
+	if v >= xs[len(xs)-1] {
+		return ys[len(ys)-1]
+	}
+	k := 0
+	for xs[k+1] <= v {
+		k++
+	}
+	return ys[k] + (ys[k+1]-ys[k])*(v-xs[k])/(xs[k+1]-xs[k])
+}
+
+// interpDown returns the linear interpolation of y at v over the non-decreasing
+// knots xs, taking the first knot when v equals repeated knot values.
+func interpDown(v float64, xs, ys []float64) float64 {
+	if v <= xs[0] {
+		return ys[0]
+	}
+	if v > xs[len(xs)-1] {
+		return ys[len(ys)-1]
+	}
+	j := len(xs) - 1
+	for xs[j-1] >= v {
+		j--
+	}
+	return ys[j-1] + (ys[j]-ys[j-1])*(v-xs[j-1])/(xs[j]-xs[j-1])
+}
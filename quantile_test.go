@@ -0,0 +1,102 @@
+// Copyright ©2026 The bíogo.nn Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mnist
+
+import (
+	"math"
+	"math/rand"
+	"sort"
+	"testing"
+)
+
+func TestQuantileTransform(t *testing.T) {
+	// Build a set of 2×2 images where each pixel position has
+	// a different skewed distribution over the byte values.
+	const n = 5000
+	r := rand.New(rand.NewSource(1))
+	s := Set{count: n, rows: 2, cols: 2, matrix: make([]byte, n*4), labels: make([]byte, n)}
+	for i := 0; i < n; i++ {
+		for p, exp := range []float64{0.5, 1, 1.5, 2} {
+			s.matrix[i*4+p] = byte(256 * math.Pow(r.Float64(), exp))
+		}
+	}
+
+	fs := s.QuantileTransform(1000)
+	if fs.Len() != n || fs.Dim() != 4 {
+		t.Fatalf("Unexpected shape: got: %d×%d want: %d×4", fs.Len(), fs.Dim(), n)
+	}
+	for p := 0; p < 4; p++ {
+		vals := make([]float64, n)
+		for i := range vals {
+			_, vec := fs.Index(i)
+			vals[i] = float64(vec[p])
+		}
+		// Kolmogorov-Smirnov statistic against the uniform distribution.
+		sort.Float64s(vals)
+		var d float64
+		for i, v := range vals {
+			d = math.Max(d, math.Max(float64(i+1)/n-v, v-float64(i)/n))
+		}
+		if d > 0.05 {
+			t.Errorf("Unexpected KS statistic for pixel %d: got: %v want: ≤0.05", p, d)
+		}
+	}
+
+	fs = Test.QuantileTransform(100)
+	for i := 0; i < fs.Len(); i++ {
+		_, vec := fs.Index(i)
+		_, img := Test.Index(i)
+		for p, v := range vec {
+			if v < 0 || v > 1 {
+				t.Fatalf("Value out of range at %d[%d]: %v", i, p, v)
+			}
+			if j := (i + 1) % Test.Len(); img[p] > Test.matrix[j*len(img)+p] {
+				_, w := fs.Index(j)
+				if v < w[p] {
+					t.Fatalf("Transform not monotonic at pixel %d", p)
+				}
+			}
+		}
+	}
+}
+
+func TestRobustScale(t *testing.T) {
+	const n = 5001
+	r := rand.New(rand.NewSource(1))
+	s := Set{count: n, rows: 2, cols: 2, matrix: make([]byte, n*4), labels: make([]byte, n)}
+	for i := 0; i < n; i++ {
+		for p, exp := range []float64{0.5, 1, 1.5, 2} {
+			s.matrix[i*4+p] = byte(256 * math.Pow(r.Float64(), exp))
+		}
+	}
+	// Add outliers that must not affect the scaling.
+	for p := 0; p < 4; p++ {
+		s.matrix[p] = 255
+	}
+
+	fs := s.RobustScale(0.25, 0.75)
+	for p := 0; p < 4; p++ {
+		vals := make([]float64, n)
+		for i := range vals {
+			_, vec := fs.Index(i)
+			vals[i] = float64(vec[p])
+		}
+		sort.Float64s(vals)
+		quantile := func(q float64) float64 {
+			pos := q * (n - 1)
+			lo := math.Floor(pos)
+			if lo == pos {
+				return vals[int(lo)]
+			}
+			return vals[int(lo)] + (pos-lo)*(vals[int(lo)+1]-vals[int(lo)])
+		}
+		if median := quantile(0.5); math.Abs(median) > 1e-6 {
+			t.Errorf("Unexpected median for pixel %d: got: %v want: 0", p, median)
+		}
+		if iqr := quantile(0.75) - quantile(0.25); math.Abs(iqr-1) > 1e-4 {
+			t.Errorf("Unexpected IQR for pixel %d: got: %v want: 1", p, iqr)
+		}
+	}
+}
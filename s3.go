@@ -0,0 +1,72 @@
+// Copyright ©2026 The bíogo.nn Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+//go:build s3
+
+package mnist
+
+import (
+	"context"
+	"io"
+	"net/url"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awsconfig "github.com/aws/aws-sdk-go-v2/config"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+)
+
+// WithS3Client returns an Option that causes objects to be retrieved from S3
+// using client. By default a client is created using the credentials and
+// region found in the standard AWS environment variables and shared
+// configuration files.
+//
+// WithS3Client is only available when the package is built with the s3
+// build tag.
+func WithS3Client(client *s3.Client) Option {
+	return func(c *config) { c.s3 = s3Getter(client) }
+}
+
+// LoadFromS3 returns a Set read from the gzipped IDX image and label objects
+// with the keys imageKey and labelKey in the S3 bucket, without altering Train
+// and Test. The objects are retrieved and cached in the same way as the files
+// of LoadFromHTTP, so WithDataDir, OfflineMode and progress reporting apply.
+// Cached objects are named by their bucket and full key.
+//
+// LoadFromS3 is only available when the package is built with the s3 build
+// tag, which avoids linking the AWS SDK into programs that do not use S3.
+func LoadFromS3(bucket, imageKey, labelKey string, opts ...Option) (Set, error) {
+	return loadURLs(s3URL(bucket, imageKey), s3URL(bucket, labelKey), newConfig(opts))
+}
+
+// s3URL returns the s3 scheme URL for the object with the given bucket and key.
+func s3URL(bucket, key string) string {
+	return (&url.URL{Scheme: "s3", Host: bucket, Path: "/" + key}).String()
+}
+
+// openS3 returns a reader for the S3 object described by the s3 scheme URL u.
+func openS3(cfg *config, u *url.URL) (io.ReadCloser, error) {
+	if cfg.s3 == nil {
+		awsCfg, err := awsconfig.LoadDefaultConfig(context.Background())
+		if err != nil {
+			return nil, err
+		}
+		cfg.s3 = s3Getter(s3.NewFromConfig(awsCfg))
+	}
+	return cfg.s3(u.Host, strings.TrimPrefix(u.Path, "/"))
+}
+
+// s3Getter returns a function that retrieves objects from S3 using client.
+func s3Getter(client *s3.Client) func(bucket, key string) (io.ReadCloser, error) {
+	return func(bucket, key string) (io.ReadCloser, error) {
+		obj, err := client.GetObject(context.Background(), &s3.GetObjectInput{
+			Bucket: aws.String(bucket),
+			Key:    aws.String(key),
+		})
+		if err != nil {
+			return nil, err
+		}
+		return obj.Body, nil
+	}
+}
@@ -0,0 +1,18 @@
+// Copyright ©2026 The bíogo.nn Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+//go:build !s3
+
+package mnist
+
+import (
+	"errors"
+	"io"
+	"net/url"
+)
+
+// openS3 returns an error since S3 support requires the s3 build tag.
+func openS3(*config, *url.URL) (io.ReadCloser, error) {
+	return nil, errors.New("mnist: s3 URLs require the s3 build tag")
+}
@@ -0,0 +1,140 @@
+// Copyright ©2026 The bíogo.nn Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+//go:build s3
+
+package mnist
+
+import (
+	"bytes"
+	"compress/gzip"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"sync"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+)
+
+// s3Server returns a client for a minimal S3 server that serves the given
+// objects, keyed by "bucket/key", for path-style GetObject requests.
+func s3Server(t *testing.T, objects map[string][]byte) (*s3.Client, *[]string) {
+	var (
+		mu       sync.Mutex
+		requests []string
+	)
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		requests = append(requests, r.URL.Path)
+		mu.Unlock()
+		data, ok := objects[r.URL.Path[1:]]
+		if r.Method != http.MethodGet || !ok {
+			w.Header().Set("Content-Type", "application/xml")
+			w.WriteHeader(http.StatusNotFound)
+			io.WriteString(w, `<?xml version="1.0" encoding="UTF-8"?>
+<Error><Code>NoSuchKey</Code><Message>The specified key does not exist.</Message></Error>`)
+			return
+		}
+		w.Header().Set("Content-Length", strconv.Itoa(len(data)))
+		w.Write(data)
+	}))
+	t.Cleanup(srv.Close)
+	client := s3.New(s3.Options{
+		Region:       "us-east-1",
+		BaseEndpoint: aws.String(srv.URL),
+		UsePathStyle: true,
+		Credentials:  aws.AnonymousCredentials{},
+		HTTPClient:   srv.Client(),
+	})
+	return client, &requests
+}
+
+func gzipIDX(t *testing.T, encode func(io.Writer) error) []byte {
+	var buf bytes.Buffer
+	z := gzip.NewWriter(&buf)
+	err := encode(z)
+	if err != nil {
+		t.Fatalf("Unexpected error encoding: %v", err)
+	}
+	err = z.Close()
+	if err != nil {
+		t.Fatalf("Unexpected error compressing: %v", err)
+	}
+	return buf.Bytes()
+}
+
+func TestLoadFromS3(t *testing.T) {
+	want := subset(Test, 100)
+	client, requests := s3Server(t, map[string][]byte{
+		"digits/sets/images.gz": gzipIDX(t, want.encodeImages),
+		"digits/sets/labels.gz": gzipIDX(t, want.encodeLabels),
+	})
+
+	dir := t.TempDir()
+	got, err := LoadFromS3("digits", "sets/images.gz", "sets/labels.gz", WithS3Client(client), WithDataDir(dir))
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if got.Len() != want.Len() || got.Rows() != want.Rows() || got.Cols() != want.Cols() {
+		t.Fatalf("Unexpected shape: got: %d×%d×%d want: %d×%d×%d",
+			got.Len(), got.Rows(), got.Cols(), want.Len(), want.Rows(), want.Cols())
+	}
+	if !bytes.Equal(got.matrix, want.matrix) || !bytes.Equal(got.labels, want.labels) {
+		t.Error("Unexpected data")
+	}
+	if src, want := got.Metadata().Source, "s3://digits/sets/images.gz"; src != want {
+		t.Errorf("Unexpected source: got: %q want: %q", src, want)
+	}
+	if len(*requests) != 2 {
+		t.Errorf("Unexpected number of requests: got: %d want: 2", len(*requests))
+	}
+
+	// Objects kept in the data directory are reused.
+	_, err = LoadFromS3("digits", "sets/images.gz", "sets/labels.gz", WithS3Client(client), WithDataDir(dir), OfflineMode())
+	if err != nil {
+		t.Errorf("Unexpected error loading cached objects: %v", err)
+	}
+	if len(*requests) != 2 {
+		t.Errorf("Unexpected requests for cached objects: got: %d want: 2", len(*requests))
+	}
+
+	_, err = LoadFromS3("digits", "sets/images.gz", "sets/missing.gz", WithS3Client(client))
+	if err == nil {
+		t.Error("Expected error for missing object")
+	}
+}
+
+func TestLoadFromS3CacheKey(t *testing.T) {
+	a, b := subset(Test, 10), subset(Train, 20)
+	client, _ := s3Server(t, map[string][]byte{
+		"digits/a/images.gz": gzipIDX(t, a.encodeImages),
+		"digits/a/labels.gz": gzipIDX(t, a.encodeLabels),
+		"digits/b/images.gz": gzipIDX(t, b.encodeImages),
+		"digits/b/labels.gz": gzipIDX(t, b.encodeLabels),
+		"others/a/images.gz": gzipIDX(t, b.encodeImages),
+		"others/a/labels.gz": gzipIDX(t, b.encodeLabels),
+	})
+
+	dir := t.TempDir()
+	for _, test := range []struct {
+		bucket, prefix string
+		want           Set
+	}{
+		{bucket: "digits", prefix: "a/", want: a},
+		{bucket: "digits", prefix: "b/", want: b},
+		{bucket: "others", prefix: "a/", want: b},
+		{bucket: "digits", prefix: "a/", want: a},
+	} {
+		got, err := LoadFromS3(test.bucket, test.prefix+"images.gz", test.prefix+"labels.gz", WithS3Client(client), WithDataDir(dir))
+		if err != nil {
+			t.Fatalf("Unexpected error for s3://%s/%s: %v", test.bucket, test.prefix, err)
+		}
+		if !got.Equal(test.want) {
+			t.Errorf("Unexpected data for s3://%s/%s: got: %d samples want: %d", test.bucket, test.prefix, got.Len(), test.want.Len())
+		}
+	}
+}
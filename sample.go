@@ -0,0 +1,39 @@
+// Copyright ©2026 The bíogo.nn Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mnist
+
+import "math/rand"
+
+// StratifiedSample returns a new Set holding exactly perClass labelled images
+// randomly sampled from each class present in the data set. Images are sampled
+// without replacement from classes with at least perClass images and with
+// replacement from smaller classes. The samples of the returned Set are grouped
+// by class in ascending label order. StratifiedSample panics if perClass is
+// negative.
+func (s *Set) StratifiedSample(perClass int, r *rand.Rand) Set {
+	if perClass < 0 {
+		panic("mnist: negative sample count")
+	}
+	var byClass [256][]int
+	for i, l := range s.labels {
+		byClass[l] = append(byClass[l], i)
+	}
+	var idx []int
+	for _, class := range byClass {
+		switch {
+		case len(class) == 0:
+			continue
+		case len(class) >= perClass:
+			for _, j := range r.Perm(len(class))[:perClass] {
+				idx = append(idx, class[j])
+			}
+		default:
+			for k := 0; k < perClass; k++ {
+				idx = append(idx, class[r.Intn(len(class))])
+			}
+		}
+	}
+	return s.take(idx)
+}
@@ -0,0 +1,43 @@
+// Copyright ©2026 The bíogo.nn Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mnist
+
+import (
+	"bytes"
+	"math/rand"
+	"testing"
+)
+
+func TestStratifiedSample(t *testing.T) {
+	s := subset(Test, 300)
+	counts := s.labelCounts()
+	for _, perClass := range []int{0, 1, 5, 40} {
+		got := s.StratifiedSample(perClass, rand.New(rand.NewSource(1)))
+		gotCounts := got.labelCounts()
+		for l, n := range counts {
+			want := 0
+			if n != 0 {
+				want = perClass
+			}
+			if gotCounts[l] != want {
+				t.Errorf("Unexpected count for label %d with perClass=%d: got: %d want: %d", l, perClass, gotCounts[l], want)
+			}
+		}
+
+		// Each sample must be an image from the source
+		// set with the same label.
+		for i := 0; i < got.Len(); i++ {
+			label, img := got.Index(i)
+			var found bool
+			for j := 0; j < s.Len() && !found; j++ {
+				l, cand := s.Index(j)
+				found = l == label && bytes.Equal(cand, img)
+			}
+			if !found {
+				t.Errorf("Sample %d with perClass=%d not found in source set", i, perClass)
+			}
+		}
+	}
+}
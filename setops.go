@@ -0,0 +1,142 @@
+// Copyright ©2026 The bíogo.nn Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mnist
+
+import (
+	"bytes"
+	"fmt"
+	"hash/fnv"
+)
+
+// Equal returns whether s and other hold the same number of images with the
+// same dimensions and identical pixel and label data. Metadata is not compared.
+func (s *Set) Equal(other Set) bool {
+	return s.count == other.count && s.rows == other.rows && s.cols == other.cols &&
+		bytes.Equal(s.labels, other.labels) && bytes.Equal(s.matrix, other.matrix)
+}
+
+// Concatenate returns a new Set holding the labelled images of s followed
+// by those of other. The returned Set does not share memory with either
+// input. Concatenate returns an error if the image dimensions of the two
+// sets differ.
+func (s *Set) Concatenate(other Set) (Set, error) {
+	if other.Rows() != s.Rows() || other.Cols() != s.Cols() {
+		return Set{}, fmt.Errorf("mnist: mismatched image dimensions %d×%d != %d×%d",
+			other.Rows(), other.Cols(), s.Rows(), s.Cols())
+	}
+	matrix := make([]byte, 0, len(s.matrix)+len(other.matrix))
+	matrix = append(append(matrix, s.matrix...), other.matrix...)
+	labels := make([]byte, 0, len(s.labels)+len(other.labels))
+	labels = append(append(labels, s.labels...), other.labels...)
+	return Set{
+		count:  s.count + other.count,
+		rows:   s.rows,
+		cols:   s.cols,
+		matrix: matrix,
+		labels: labels,
+	}, nil
+}
+
+// DeduplicateByContent returns a new Set holding the labelled images of s with
+// any image whose pixels exactly match those of an earlier image removed.
+// Duplicates are found by FNV-1a hashing of the pixels of each image.
+func (s *Set) DeduplicateByContent() Set {
+	seen := make(contentIndex)
+	var keep []int
+	for i := 0; i < s.Len(); i++ {
+		_, img := s.Index(i)
+		if seen.contains(img) {
+			continue
+		}
+		seen.add(img)
+		keep = append(keep, i)
+	}
+	return s.take(keep)
+}
+
+// Diff returns a new Set holding the labelled images of s whose pixels do
+// not exactly match those of any image in other, in their original order.
+// Images are compared using the same hashing as DeduplicateByContent.
+func (s *Set) Diff(other Set) Set {
+	seen := newContentIndex(&other)
+	var keep []int
+	for i := 0; i < s.Len(); i++ {
+		_, img := s.Index(i)
+		if !seen.contains(img) {
+			keep = append(keep, i)
+		}
+	}
+	return s.take(keep)
+}
+
+// OverlapFraction returns the fraction of the images of s whose pixels exactly
+// match those of an image in other. It returns zero if s is empty.
+func (s *Set) OverlapFraction(other Set) float64 {
+	if s.Len() == 0 {
+		return 0
+	}
+	seen := newContentIndex(&other)
+	var n int
+	for i := 0; i < s.Len(); i++ {
+		_, img := s.Index(i)
+		if seen.contains(img) {
+			n++
+		}
+	}
+	return float64(n) / float64(s.Len())
+}
+
+// contentIndex is a set of images indexed by the hash of their pixels.
+type contentIndex map[uint64][][]byte
+
+// newContentIndex returns a contentIndex holding the images of s.
+func newContentIndex(s *Set) contentIndex {
+	c := make(contentIndex)
+	for i := 0; i < s.Len(); i++ {
+		_, img := s.Index(i)
+		c.add(img)
+	}
+	return c
+}
+
+func (c contentIndex) add(img []byte) {
+	h := hashImage(img)
+	c[h] = append(c[h], img)
+}
+
+func (c contentIndex) contains(img []byte) bool {
+	for _, other := range c[hashImage(img)] {
+		if bytes.Equal(img, other) {
+			return true
+		}
+	}
+	return false
+}
+
+// hashImage returns the FNV-1a hash of the pixels of img.
+func hashImage(img []byte) uint64 {
+	h := fnv.New64a()
+	h.Write(img)
+	return h.Sum64()
+}
+
+// take returns a new Set holding the labelled images of s at the given
+// indices, in order.
+func (s *Set) take(indices []int) Set {
+	stride := s.Rows() * s.Cols()
+	t := Set{
+		count:  int32(len(indices)),
+		rows:   s.rows,
+		cols:   s.cols,
+		matrix: make([]byte, 0, len(indices)*stride),
+		labels: make([]byte, 0, len(indices)),
+	}
+	for _, i := range indices {
+		label, img := s.Index(i)
+		t.matrix = append(t.matrix, img...)
+		t.labels = append(t.labels, label)
+	}
+	return t
+}
@@ -0,0 +1,157 @@
+// Copyright ©2026 The bíogo.nn Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mnist
+
+import (
+	"bytes"
+	"testing"
+)
+
+func TestEqual(t *testing.T) {
+	s := subset(Test, 100)
+	if !s.Equal(s) {
+		t.Error("Set not equal to itself")
+	}
+	c := s
+	c.labels = s.Labels()
+	c.matrix = s.Images()
+	if !s.Equal(c) {
+		t.Error("Set not equal to copy")
+	}
+
+	c.matrix[len(c.matrix)/2]++
+	if s.Equal(c) {
+		t.Error("Sets differing in a pixel reported equal")
+	}
+	c.matrix = s.Images()
+
+	c.labels[10] = (c.labels[10] + 1) % numClasses
+	if s.Equal(c) {
+		t.Error("Sets differing in a label reported equal")
+	}
+	c.labels = s.Labels()
+
+	c.rows, c.cols = s.rows*2, s.cols/2
+	if s.Equal(c) {
+		t.Error("Sets differing in dimensions reported equal")
+	}
+
+	if s.Equal(subset(Test, 99)) {
+		t.Error("Sets differing in length reported equal")
+	}
+}
+
+func TestConcatenate(t *testing.T) {
+	all, err := Train.Concatenate(Test)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if all.Len() != Train.Len()+Test.Len() {
+		t.Errorf("Unexpected length: got: %d want: %d", all.Len(), Train.Len()+Test.Len())
+	}
+	if all.Rows() != Train.Rows() || all.Cols() != Train.Cols() {
+		t.Errorf("Unexpected dimensions: got: %d×%d want: %d×%d", all.Rows(), all.Cols(), Train.Rows(), Train.Cols())
+	}
+	for _, i := range []int{0, Train.Len() - 1, Train.Len(), all.Len() - 1} {
+		src, j := &Train, i
+		if i >= Train.Len() {
+			src, j = &Test, i-Train.Len()
+		}
+		gotLabel, gotImage := all.Index(i)
+		wantLabel, wantImage := src.Index(j)
+		if gotLabel != wantLabel || !bytes.Equal(gotImage, wantImage) {
+			t.Errorf("Unexpected sample %d", i)
+		}
+	}
+	all.matrix[0]++
+	if all.matrix[0] == Train.matrix[0] {
+		t.Error("Concatenated set shares memory with input")
+	}
+
+	patches, err := Test.Patches(14)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	_, err = Train.Concatenate(patches)
+	if err == nil {
+		t.Error("Expected error for mismatched dimensions")
+	}
+}
+
+func TestDeduplicateByContent(t *testing.T) {
+	base := Train.DeduplicateByContent()
+	dup, err := Train.Concatenate(subset(Train, 100))
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	got := dup.DeduplicateByContent()
+	if got.Len() != dup.Len()-100 {
+		t.Errorf("Unexpected length after deduplication: got: %d want: %d", got.Len(), dup.Len()-100)
+	}
+	if got.Len() != base.Len() {
+		t.Errorf("Unexpected length compared to original deduplication: got: %d want: %d", got.Len(), base.Len())
+	}
+	for i := 0; i < got.Len(); i += 113 {
+		gl, gi := got.Index(i)
+		bl, bi := base.Index(i)
+		if gl != bl || !bytes.Equal(gi, bi) {
+			t.Fatalf("Unexpected sample %d after deduplication", i)
+		}
+	}
+}
+
+func TestDiff(t *testing.T) {
+	d := Train.Diff(Test)
+	if d.Len() < Train.Len()-Train.Len()/1000 {
+		t.Errorf("Unexpected length of Train.Diff(Test): got: %d want: ~%d", d.Len(), Train.Len())
+	}
+
+	s := subset(Test, 50)
+	other := Set{
+		count:  20,
+		rows:   s.rows,
+		cols:   s.cols,
+		matrix: append(append([]byte(nil), s.matrix[10*28*28:20*28*28]...), subset(Train, 10).matrix...),
+		labels: make([]byte, 20),
+	}
+	got := s.Diff(other)
+	want := s.take(append(seq(0, 10), seq(20, 50)...))
+	if got.Len() != want.Len() {
+		t.Fatalf("Unexpected length of difference: got: %d want: %d", got.Len(), want.Len())
+	}
+	if !bytes.Equal(got.matrix, want.matrix) || !bytes.Equal(got.labels, want.labels) {
+		t.Error("Unexpected difference")
+	}
+
+	if got := s.Diff(s); got.Len() != 0 {
+		t.Errorf("Unexpected length of self difference: got: %d want: 0", got.Len())
+	}
+}
+
+// seq returns the integers in [from, to).
+func seq(from, to int) []int {
+	s := make([]int, 0, to-from)
+	for i := from; i < to; i++ {
+		s = append(s, i)
+	}
+	return s
+}
+
+func TestOverlapFraction(t *testing.T) {
+	s := subset(Test, 1000)
+	if got := s.OverlapFraction(s); got != 1 {
+		t.Errorf("Unexpected self overlap: got: %v want: 1", got)
+	}
+	if got := Train.OverlapFraction(Test); got >= 0.001 {
+		t.Errorf("Unexpected Train/Test overlap: got: %v want: <0.001", got)
+	}
+	half := subset(s, 250)
+	if got := s.OverlapFraction(half); got != 0.25 {
+		t.Errorf("Unexpected partial overlap: got: %v want: 0.25", got)
+	}
+	if got := (&Set{}).OverlapFraction(s); got != 0 {
+		t.Errorf("Unexpected overlap for empty set: got: %v want: 0", got)
+	}
+}
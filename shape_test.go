@@ -0,0 +1,358 @@
+// Copyright ©2026 The bíogo.nn Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mnist
+
+import (
+	"bytes"
+	"image"
+	"math"
+	"reflect"
+	"testing"
+)
+
+// drawSet returns a Set holding a single image drawn by the given rows,
+// where '#' is a foreground pixel with value 255 and any other byte is
+// a background pixel with value 0.
+func drawSet(rows ...string) Set {
+	s := Set{count: 1, rows: int32(len(rows)), labels: []byte{0}}
+	if len(rows) != 0 {
+		s.cols = int32(len(rows[0]))
+	}
+	for _, r := range rows {
+		for _, c := range []byte(r) {
+			if c == '#' {
+				s.matrix = append(s.matrix, 255)
+			} else {
+				s.matrix = append(s.matrix, 0)
+			}
+		}
+	}
+	return s
+}
+
+var connectedComponentsTests = []struct {
+	name  string
+	image []string
+	want  []image.Rectangle
+}{
+	{
+		name: "blank",
+		image: []string{
+			".....",
+			".....",
+			".....",
+		},
+		want: []image.Rectangle{},
+	},
+	{
+		name: "cross",
+		image: []string{
+			".......",
+			"...#...",
+			"...#...",
+			".#####.",
+			"...#...",
+			"...#...",
+			".......",
+		},
+		want: []image.Rectangle{image.Rect(1, 1, 6, 6)},
+	},
+	{
+		name: "diagonal",
+		image: []string{
+			"#....",
+			".#...",
+			"..#..",
+			"...#.",
+		},
+		want: []image.Rectangle{image.Rect(0, 0, 4, 4)},
+	},
+	{
+		name: "separate",
+		image: []string{
+			"##...#",
+			"##...#",
+			"......",
+			"..###.",
+		},
+		want: []image.Rectangle{image.Rect(0, 0, 2, 2), image.Rect(5, 0, 6, 2), image.Rect(2, 3, 5, 4)},
+	},
+}
+
+func TestConnectedComponents(t *testing.T) {
+	for _, test := range connectedComponentsTests {
+		s := drawSet(test.image...)
+		got := s.ConnectedComponents(0, 128)
+		if !reflect.DeepEqual(got, test.want) {
+			t.Errorf("Unexpected components for %q: got: %v want: %v", test.name, got, test.want)
+		}
+	}
+}
+
+func TestStrokeWidth(t *testing.T) {
+	full := Set{count: 1, rows: 28, cols: 28, matrix: bytes.Repeat([]byte{255}, 28*28), labels: []byte{0}}
+	if got := full.StrokeWidth(0); got != 14 {
+		t.Errorf("Unexpected stroke width for filled image: got: %v want: 14", got)
+	}
+
+	diag := Set{count: 1, rows: 28, cols: 28, matrix: make([]byte, 28*28), labels: []byte{0}}
+	for i := 0; i < 28; i++ {
+		diag.matrix[i*28+i] = 255
+	}
+	if got := diag.StrokeWidth(0); math.Abs(got-1) > 1e-9 {
+		t.Errorf("Unexpected stroke width for diagonal line: got: %v want: 1", got)
+	}
+
+	bar := drawSet(
+		".........",
+		".#######.",
+		".#######.",
+		".#######.",
+		".........",
+	)
+	if got := bar.StrokeWidth(0); got != 2 {
+		t.Errorf("Unexpected stroke width for bar: got: %v want: 2", got)
+	}
+
+	blank := drawSet("....", "....")
+	if got := blank.StrokeWidth(0); got != 0 {
+		t.Errorf("Unexpected stroke width for blank image: got: %v want: 0", got)
+	}
+}
+
+var symmetryTests = []struct {
+	name  string
+	image []string
+	want  float64
+}{
+	{
+		name: "symmetric",
+		image: []string{
+			"..##..",
+			".#..#.",
+			"#....#",
+			".#..#.",
+			"..##..",
+		},
+		want: 0,
+	},
+	{
+		name: "one sided",
+		image: []string{
+			"##....",
+			"###...",
+			"#.....",
+		},
+		want: 1,
+	},
+	{
+		name: "half",
+		image: []string{
+			"##.#",
+			"#..#",
+		},
+		want: 0.2,
+	},
+	{
+		name: "blank",
+		image: []string{
+			"....",
+		},
+		want: 0,
+	},
+}
+
+func TestSymmetry(t *testing.T) {
+	for _, test := range symmetryTests {
+		s := drawSet(test.image...)
+		got := s.Symmetry(0)
+		if math.Abs(got-test.want) > 1e-12 {
+			t.Errorf("Unexpected symmetry for %q: got: %v want: %v", test.name, got, test.want)
+		}
+	}
+	for i := 0; i < Test.Len(); i++ {
+		got := Test.Symmetry(i)
+		if got < 0 || got > 1 {
+			t.Fatalf("Symmetry out of range for image %d: %v", i, got)
+		}
+	}
+}
+
+var perimeterTests = []struct {
+	name  string
+	image []string
+	want  float64
+}{
+	{
+		name: "blank",
+		image: []string{
+			"....",
+			"....",
+		},
+		want: 0,
+	},
+	{
+		name: "pixel",
+		image: []string{
+			"...",
+			".#.",
+			"...",
+		},
+		want: 1,
+	},
+	{
+		name: "square",
+		image: []string{
+			".....",
+			".###.",
+			".###.",
+			".###.",
+			".....",
+		},
+		want: 8,
+	},
+	{
+		name: "edge square",
+		image: []string{
+			"###",
+			"###",
+			"###",
+		},
+		want: 8,
+	},
+	{
+		name: "ring",
+		image: []string{
+			"#####",
+			"#...#",
+			"#####",
+		},
+		want: 12,
+	},
+}
+
+func TestPerimeter(t *testing.T) {
+	for _, test := range perimeterTests {
+		s := drawSet(test.image...)
+		got := s.Perimeter(0, 128)
+		if got != test.want {
+			t.Errorf("Unexpected perimeter for %q: got: %v want: %v", test.name, got, test.want)
+		}
+	}
+}
+
+func TestArea(t *testing.T) {
+	blank := Set{count: 1, rows: 28, cols: 28, matrix: make([]byte, 28*28), labels: []byte{0}}
+	if got := blank.Area(0, 1); got != 0 {
+		t.Errorf("Unexpected area for blank image: got: %d want: 0", got)
+	}
+	full := Set{count: 1, rows: 28, cols: 28, matrix: bytes.Repeat([]byte{255}, 28*28), labels: []byte{0}}
+	if got := full.Area(0, 255); got != 28*28 {
+		t.Errorf("Unexpected area for full image: got: %d want: %d", got, 28*28)
+	}
+
+	s := Set{
+		count: 1, rows: 3, cols: 4,
+		matrix: []byte{
+			0, 10, 127, 128,
+			255, 200, 0, 1,
+			129, 0, 50, 128,
+		},
+		labels: []byte{0},
+	}
+	for _, test := range []struct {
+		threshold byte
+		want      int
+	}{
+		{threshold: 0, want: 12},
+		{threshold: 1, want: 9},
+		{threshold: 128, want: 5},
+		{threshold: 129, want: 3},
+		{threshold: 255, want: 1},
+	} {
+		if got := s.Area(0, test.threshold); got != test.want {
+			t.Errorf("Unexpected area for threshold %d: got: %d want: %d", test.threshold, got, test.want)
+		}
+	}
+}
+
+func TestCompactness(t *testing.T) {
+	disc := Set{count: 1, rows: 28, cols: 28, matrix: make([]byte, 28*28), labels: []byte{0}}
+	const c, r = 13.5, 13
+	for y := 0; y < 28; y++ {
+		for x := 0; x < 28; x++ {
+			dx, dy := float64(x)-c, float64(y)-c
+			if dx*dx+dy*dy <= r*r {
+				disc.matrix[y*28+x] = 255
+			}
+		}
+	}
+	if got := disc.Area(0, 128); got != 540 {
+		t.Errorf("Unexpected area for disc: got: %d want: 540", got)
+	}
+	if got := disc.Perimeter(0, 128); got != 72 {
+		t.Errorf("Unexpected perimeter for disc: got: %v want: 72", got)
+	}
+	// The pixel count perimeter is shorter than the 2πr boundary
+	// of the continuous disc, so the compactness exceeds 1.
+	discCompactness := disc.Compactness(0, 128)
+	if want := 4 * math.Pi * 540 / (72 * 72); math.Abs(discCompactness-want) > 1e-12 {
+		t.Errorf("Unexpected compactness for disc: got: %v want: %v", discCompactness, want)
+	}
+
+	line := Set{count: 1, rows: 28, cols: 28, matrix: make([]byte, 28*28), labels: []byte{0}}
+	for x := 0; x < 28; x++ {
+		line.matrix[14*28+x] = 255
+	}
+	lineCompactness := line.Compactness(0, 128)
+	if lineCompactness > 0.5 || lineCompactness > discCompactness/2 {
+		t.Errorf("Unexpected compactness for line: got: %v want: <0.5", lineCompactness)
+	}
+
+	blank := Set{count: 1, rows: 28, cols: 28, matrix: make([]byte, 28*28), labels: []byte{0}}
+	if got := blank.Compactness(0, 128); got != 0 {
+		t.Errorf("Unexpected compactness for blank image: got: %v want: 0", got)
+	}
+}
+
+func TestPointCloud(t *testing.T) {
+	blank := Set{count: 1, rows: 28, cols: 28, matrix: make([]byte, 28*28), labels: []byte{0}}
+	if got := blank.PointCloud(0, 1); len(got) != 0 {
+		t.Errorf("Unexpected number of points for blank image: got: %d want: 0", len(got))
+	}
+
+	full := Set{count: 1, rows: 28, cols: 28, matrix: bytes.Repeat([]byte{255}, 28*28), labels: []byte{0}}
+	got := full.PointCloud(0, 128)
+	if len(got) != 28*28 {
+		t.Fatalf("Unexpected number of points for full image: got: %d want: %d", len(got), 28*28)
+	}
+	for i, p := range got {
+		if want := image.Pt(i%28, i/28); p != want {
+			t.Fatalf("Unexpected point %d for full image: got: %v want: %v", i, p, want)
+		}
+	}
+
+	s := drawSet(
+		"#...",
+		"..#.",
+		"...#",
+	)
+	want := []image.Point{{X: 0, Y: 0}, {X: 2, Y: 1}, {X: 3, Y: 2}}
+	if got := s.PointCloud(0, 128); !reflect.DeepEqual(got, want) {
+		t.Errorf("Unexpected points: got: %v want: %v", got, want)
+	}
+
+	for i := 0; i < Test.Len(); i += 97 {
+		_, img := Test.Index(i)
+		for _, p := range Test.PointCloud(i, 128) {
+			if img[p.Y*Test.Cols()+p.X] < 128 {
+				t.Fatalf("Unexpected background point in image %d: %v", i, p)
+			}
+		}
+		if n := len(Test.PointCloud(i, 128)); n != Test.Area(i, 128) {
+			t.Fatalf("Unexpected number of points for image %d: got: %d want: %d", i, n, Test.Area(i, 128))
+		}
+	}
+}
@@ -0,0 +1,24 @@
+// Copyright ©2026 The bíogo.nn Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mnist
+
+import "sort"
+
+// SortedByProperty returns a new Set holding the labelled images of the data
+// set sorted in ascending order of the value returned by property for each
+// labelled image. Samples with equal property values retain their relative
+// order. The property function must not modify the image it is passed.
+func (s *Set) SortedByProperty(property func(label byte, image []byte) float64) Set {
+	vals := make([]float64, s.Len())
+	idx := make([]int, s.Len())
+	for i := range idx {
+		idx[i] = i
+		vals[i] = property(s.Index(i))
+	}
+	sort.SliceStable(idx, func(i, j int) bool {
+		return vals[idx[i]] < vals[idx[j]]
+	})
+	return s.take(idx)
+}
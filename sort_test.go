@@ -0,0 +1,52 @@
+// Copyright ©2026 The bíogo.nn Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mnist
+
+import (
+	"bytes"
+	"testing"
+)
+
+func TestSortedByProperty(t *testing.T) {
+	s := subset(Test, 500)
+	orig := s.Labels()
+	got := s.SortedByProperty(func(l byte, _ []byte) float64 { return float64(l) })
+	if !bytes.Equal(s.labels, orig) {
+		t.Error("SortedByProperty altered source data")
+	}
+
+	// Build the expected stable sort by label with a counting sort.
+	var byLabel [numClasses][]int
+	for i, l := range s.labels {
+		byLabel[l] = append(byLabel[l], i)
+	}
+	var idx []int
+	for _, c := range byLabel {
+		idx = append(idx, c...)
+	}
+	want := s.take(idx)
+	if !got.Equal(want) {
+		t.Error("Unexpected result for label-sorted set")
+	}
+
+	ink := func(_ byte, img []byte) float64 {
+		var sum float64
+		for _, v := range img {
+			sum += float64(v)
+		}
+		return sum
+	}
+	got = s.SortedByProperty(ink)
+	if got.Len() != s.Len() {
+		t.Fatalf("Unexpected length: got: %d want: %d", got.Len(), s.Len())
+	}
+	for i := 1; i < got.Len(); i++ {
+		prev, cur := ink(got.Index(i-1)), ink(got.Index(i))
+		if prev > cur {
+			t.Errorf("Samples out of order at %d: %v > %v", i, prev, cur)
+			break
+		}
+	}
+}
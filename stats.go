@@ -0,0 +1,171 @@
+// Copyright ©2026 The bíogo.nn Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mnist
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"sort"
+	"text/tabwriter"
+	"unsafe"
+)
+
+// Summarize writes a human-readable table of statistics describing the data set to w.
+// The table includes the number of samples, the image dimensions, the number of samples
+// in each class, the global pixel minimum, maximum, mean and standard deviation, and the
+// memory used by the data set.
+func (s *Set) Summarize(w io.Writer) error {
+	tw := tabwriter.NewWriter(w, 0, 8, 2, ' ', 0)
+
+	fmt.Fprintf(tw, "samples:\t%d\n", s.Len())
+	fmt.Fprintf(tw, "dimensions:\t%d×%d\n", s.Rows(), s.Cols())
+
+	counts := s.labelCounts()
+	for label, n := range counts {
+		if n == 0 {
+			continue
+		}
+		fmt.Fprintf(tw, "class %d:\t%d\n", label, n)
+	}
+
+	min, max, mean, std := s.pixelStats()
+	fmt.Fprintf(tw, "pixel min:\t%d\n", min)
+	fmt.Fprintf(tw, "pixel max:\t%d\n", max)
+	fmt.Fprintf(tw, "pixel mean:\t%.4f\n", mean)
+	fmt.Fprintf(tw, "pixel std:\t%.4f\n", std)
+	fmt.Fprintf(tw, "memory:\t%.2f MB\n", float64(s.MemoryBytes())/(1<<20))
+
+	return tw.Flush()
+}
+
+// MemoryBytes returns the number of bytes used to hold the data set,
+// including the Set value itself.
+func (s *Set) MemoryBytes() int64 {
+	return int64(len(s.matrix)) + int64(len(s.labels)) + int64(unsafe.Sizeof(*s))
+}
+
+// Entropy returns the Shannon entropy of the label distribution of the
+// data set in bits.
+func (s *Set) Entropy() float64 {
+	n := float64(s.Len())
+	var h float64
+	for _, c := range s.labelCounts() {
+		if c == 0 {
+			continue
+		}
+		p := float64(c) / n
+		h -= p * math.Log2(p)
+	}
+	return h
+}
+
+// ClassWeights returns a weight for each label in the data set that compensates
+// for class imbalance. The weight for a class is n/(k*c) where n is the number of
+// samples, k is the number of distinct labels and c is the number of samples with
+// the label.
+func (s *Set) ClassWeights() map[byte]float64 {
+	counts := s.labelCounts()
+	var k int
+	for _, c := range counts {
+		if c != 0 {
+			k++
+		}
+	}
+	w := make(map[byte]float64, k)
+	for l, c := range counts {
+		if c == 0 {
+			continue
+		}
+		w[byte(l)] = float64(s.Len()) / float64(k*c)
+	}
+	return w
+}
+
+// TopVariancePixels returns the indices of the n pixel positions with the greatest
+// variance over the images of the data set, in descending order of variance.
+func (s *Set) TopVariancePixels(n int) ([]int, error) {
+	dim := s.Rows() * s.Cols()
+	if n < 0 || n > dim {
+		return nil, errors.New("mnist: invalid number of pixels")
+	}
+	variance := s.pixelVariances()
+	idx := make([]int, dim)
+	for i := range idx {
+		idx[i] = i
+	}
+	sort.SliceStable(idx, func(i, j int) bool {
+		return variance[idx[i]] > variance[idx[j]]
+	})
+	return idx[:n:n], nil
+}
+
+// pixelVariances returns the population variance of each pixel
+// position over the images of the data set.
+func (s *Set) pixelVariances() []float64 {
+	dim := s.Rows() * s.Cols()
+	sum := make([]float64, dim)
+	sumSq := make([]float64, dim)
+	for i := 0; i < s.Len(); i++ {
+		_, img := s.Index(i)
+		for j, v := range img {
+			f := float64(v)
+			sum[j] += f
+			sumSq[j] += f * f
+		}
+	}
+	n := float64(s.Len())
+	variance := make([]float64, dim)
+	if n == 0 {
+		return variance
+	}
+	for j := range variance {
+		mean := sum[j] / n
+		variance[j] = math.Max(0, sumSq[j]/n-mean*mean)
+	}
+	return variance
+}
+
+// labelCounts returns the number of samples with each label value.
+func (s *Set) labelCounts() (counts [256]int) {
+	for _, l := range s.labels {
+		counts[l]++
+	}
+	return counts
+}
+
+// pixelStats returns the minimum, maximum, mean and standard deviation
+// of all pixel values in the data set.
+func (s *Set) pixelStats() (min, max byte, mean, std float64) {
+	if len(s.matrix) == 0 {
+		return 0, 0, 0, 0
+	}
+	var hist [256]int
+	for _, v := range s.matrix {
+		hist[v]++
+	}
+	min, max = 255, 0
+	n := float64(len(s.matrix))
+	for v, c := range hist {
+		if c == 0 {
+			continue
+		}
+		if byte(v) < min {
+			min = byte(v)
+		}
+		if byte(v) > max {
+			max = byte(v)
+		}
+		mean += float64(v) * float64(c)
+	}
+	mean /= n
+	for v, c := range hist {
+		d := float64(v) - mean
+		std += d * d * float64(c)
+	}
+	std = math.Sqrt(std / n)
+	return min, max, mean, std
+}
@@ -0,0 +1,202 @@
+// Copyright ©2026 The bíogo.nn Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mnist
+
+import (
+	"bytes"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"testing"
+	"unsafe"
+)
+
+func TestSummarize(t *testing.T) {
+	for _, test := range []struct {
+		set  Set
+		name string
+	}{
+		{set: Test, name: "Test"},
+		{set: Train, name: "Train"},
+	} {
+		var buf bytes.Buffer
+		err := test.set.Summarize(&buf)
+		if err != nil {
+			t.Errorf("Unexpected error for %q: %v", test.name, err)
+		}
+
+		var (
+			keys   []string
+			fields = make(map[string]string)
+		)
+		for _, line := range strings.Split(strings.TrimSuffix(buf.String(), "\n"), "\n") {
+			key, val, ok := strings.Cut(line, ":")
+			if !ok {
+				t.Errorf("Unexpected summary line for %q: %q", test.name, line)
+				continue
+			}
+			keys = append(keys, key)
+			fields[key] = strings.TrimSpace(val)
+		}
+		if len(keys) == 0 || keys[0] != "samples" {
+			t.Fatalf("Unexpected summary header for %q: got: %q want: %q", test.name, keys, "samples")
+		}
+		if got, want := fields["samples"], strconv.Itoa(test.set.Len()); got != want {
+			t.Errorf("Unexpected samples for %q: got: %s want: %s", test.name, got, want)
+		}
+		if got, want := fields["dimensions"], "28×28"; got != want {
+			t.Errorf("Unexpected dimensions for %q: got: %s want: %s", test.name, got, want)
+		}
+
+		var wantCounts [10]int
+		for _, l := range test.set.labels {
+			wantCounts[l]++
+		}
+		var sum int
+		for label, want := range wantCounts {
+			val, ok := fields[fmt.Sprintf("class %d", label)]
+			if !ok {
+				t.Errorf("Missing count for class %d for %q", label, test.name)
+				continue
+			}
+			got, err := strconv.Atoi(val)
+			if err != nil {
+				t.Errorf("Unexpected count for class %d for %q: %v", label, test.name, err)
+				continue
+			}
+			if got != want {
+				t.Errorf("Unexpected count for class %d for %q: got: %d want: %d", label, test.name, got, want)
+			}
+			sum += got
+		}
+		if sum != test.set.Len() {
+			t.Errorf("Unexpected class count total for %q: got: %d want: %d", test.name, sum, test.set.Len())
+		}
+
+		lo, hi := byte(255), byte(0)
+		var mean float64
+		for _, v := range test.set.matrix {
+			lo = min(lo, v)
+			hi = max(hi, v)
+			mean += float64(v)
+		}
+		mean /= float64(len(test.set.matrix))
+		var variance float64
+		for _, v := range test.set.matrix {
+			d := float64(v) - mean
+			variance += d * d
+		}
+		std := math.Sqrt(variance / float64(len(test.set.matrix)))
+		if got, want := fields["pixel min"], strconv.Itoa(int(lo)); got != want {
+			t.Errorf("Unexpected pixel min for %q: got: %s want: %s", test.name, got, want)
+		}
+		if got, want := fields["pixel max"], strconv.Itoa(int(hi)); got != want {
+			t.Errorf("Unexpected pixel max for %q: got: %s want: %s", test.name, got, want)
+		}
+		for _, f := range []struct {
+			key  string
+			want float64
+		}{
+			{key: "pixel mean", want: mean},
+			{key: "pixel std", want: std},
+		} {
+			got, err := strconv.ParseFloat(fields[f.key], 64)
+			if err != nil {
+				t.Errorf("Unexpected %s for %q: %v", f.key, test.name, err)
+				continue
+			}
+			if math.Abs(got-f.want) > 1e-4 {
+				t.Errorf("Unexpected %s for %q: got: %v want: %.4f", f.key, test.name, got, f.want)
+			}
+		}
+	}
+}
+
+func TestMemoryBytes(t *testing.T) {
+	want := int64(60000*28*28+60000) + int64(unsafe.Sizeof(Set{}))
+	got := Train.MemoryBytes()
+	if got != want {
+		t.Errorf("Unexpected memory use for Train: got: %d want: %d", got, want)
+	}
+}
+
+func TestEntropy(t *testing.T) {
+	balanced := Set{labels: []byte{0, 1, 2, 3, 4, 5, 6, 7, 8, 9}, count: 10}
+	got := balanced.Entropy()
+	if math.Abs(got-math.Log2(10)) > 1e-12 {
+		t.Errorf("Unexpected entropy for balanced set: got: %v want: %v", got, math.Log2(10))
+	}
+
+	single := Set{labels: []byte{7, 7, 7, 7}, count: 4}
+	got = single.Entropy()
+	if got != 0 {
+		t.Errorf("Unexpected entropy for single label set: got: %v want: 0", got)
+	}
+}
+
+func TestClassWeights(t *testing.T) {
+	w := Train.ClassWeights()
+	if len(w) != 10 {
+		t.Errorf("Unexpected number of classes: got: %d want: 10", len(w))
+	}
+	for l, v := range w {
+		if math.Abs(v-1) > 0.15 {
+			t.Errorf("Unexpected weight for class %d: got: %v want: ~1", l, v)
+		}
+	}
+
+	imbalanced := Set{labels: []byte{0, 0, 0, 0, 0, 0, 0, 0, 0, 1}, count: 10}
+	w = imbalanced.ClassWeights()
+	if w[1] <= 1 {
+		t.Errorf("Unexpected weight for minority class: got: %v want: >1", w[1])
+	}
+	if w[0] >= 1 {
+		t.Errorf("Unexpected weight for majority class: got: %v want: <1", w[0])
+	}
+}
+
+func TestTopVariancePixels(t *testing.T) {
+	dim := Train.Rows() * Train.Cols()
+	const n = 50
+	idx, err := Train.TopVariancePixels(n)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if len(idx) != n {
+		t.Errorf("Unexpected number of indices: got: %d want: %d", len(idx), n)
+	}
+	variance := Train.pixelVariances()
+	for i, p := range idx {
+		if p < 0 || p >= dim {
+			t.Errorf("Index out of range: %d", p)
+			continue
+		}
+		if i > 0 && variance[p] > variance[idx[i-1]] {
+			t.Errorf("Indices not in descending variance order at %d", i)
+		}
+	}
+
+	// The centre pixel is in the upper half by variance.
+	all, err := Train.TopVariancePixels(dim)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	centre := Train.Rows()/2*Train.Cols() + Train.Cols()/2
+	var rank int
+	for rank = range all {
+		if all[rank] == centre {
+			break
+		}
+	}
+	if rank >= dim/2 {
+		t.Errorf("Unexpected variance rank for centre pixel: got: %d want: <%d", rank, dim/2)
+	}
+
+	_, err = Train.TopVariancePixels(dim + 1)
+	if err == nil {
+		t.Error("Expected error for too many pixels")
+	}
+}
@@ -0,0 +1,167 @@
+// Copyright ©2026 The bíogo.nn Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mnist
+
+import (
+	"compress/gzip"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+)
+
+// StreamReader reads labelled images one at a time from a pair of gzipped
+// IDX files without holding the complete data set in memory.
+type StreamReader struct {
+	images, labels *os.File
+	zImages        *gzip.Reader
+	zLabels        *gzip.Reader
+
+	count      int32
+	rows, cols int32
+	read       int32
+}
+
+// NewStreamReader returns a StreamReader reading from the gzipped IDX image and
+// label files. The StreamReader should be closed when it is no longer needed.
+func NewStreamReader(imagesFile, labelsFile string) (*StreamReader, error) {
+	images, err := os.Open(imagesFile)
+	if err != nil {
+		return nil, err
+	}
+	labels, err := os.Open(labelsFile)
+	if err != nil {
+		images.Close()
+		return nil, err
+	}
+	r := &StreamReader{images: images, labels: labels}
+	err = r.start()
+	if err != nil {
+		r.Close()
+		return nil, err
+	}
+	return r, nil
+}
+
+// start prepares the decompressors and reads the IDX headers
+// from the current positions of the underlying files.
+func (r *StreamReader) start() error {
+	var err error
+	if r.zImages == nil {
+		r.zImages, err = gzip.NewReader(r.images)
+	} else {
+		err = r.zImages.Reset(r.images)
+	}
+	if err != nil {
+		return err
+	}
+	if r.zLabels == nil {
+		r.zLabels, err = gzip.NewReader(r.labels)
+	} else {
+		err = r.zLabels.Reset(r.labels)
+	}
+	if err != nil {
+		return err
+	}
+
+	var magic int32
+	err = binary.Read(r.zImages, binary.BigEndian, &magic)
+	if err != nil {
+		return err
+	}
+	if magic != xIMG {
+		return fmt.Errorf("invalid magic number for images: %x", magic)
+	}
+	for _, v := range []*int32{&r.count, &r.rows, &r.cols} {
+		err = binary.Read(r.zImages, binary.BigEndian, v)
+		if err != nil {
+			return err
+		}
+	}
+	if r.count < 0 || r.rows < 0 || r.cols < 0 {
+		return errors.New("invalid image dimensions")
+	}
+
+	err = binary.Read(r.zLabels, binary.BigEndian, &magic)
+	if err != nil {
+		return err
+	}
+	if magic != xLAB {
+		return fmt.Errorf("invalid magic number for labels: %x", magic)
+	}
+	var count int32
+	err = binary.Read(r.zLabels, binary.BigEndian, &count)
+	if err != nil {
+		return err
+	}
+	if count != r.count {
+		return errors.New("mismatched number of labels and images")
+	}
+	r.read = 0
+
+	return nil
+}
+
+// Rows returns the number of pixel rows in the images of the stream.
+func (r *StreamReader) Rows() int { return int(r.rows) }
+
+// Cols returns the number of pixel columns in the images of the stream.
+func (r *StreamReader) Cols() int { return int(r.cols) }
+
+// Len returns the total number of labelled images in the stream.
+func (r *StreamReader) Len() int { return int(r.count) }
+
+// Next returns the next label and image from the stream. The returned image
+// is newly allocated. Next returns io.EOF when all images have been read.
+func (r *StreamReader) Next() (label byte, image []byte, err error) {
+	if r.read >= r.count {
+		return 0, nil, io.EOF
+	}
+	image = make([]byte, r.rows*r.cols)
+	_, err = io.ReadFull(r.zImages, image)
+	if err != nil {
+		return 0, nil, noEOF(err)
+	}
+	var l [1]byte
+	_, err = io.ReadFull(r.zLabels, l[:])
+	if err != nil {
+		return 0, nil, noEOF(err)
+	}
+	r.read++
+	return l[0], image, nil
+}
+
+// noEOF converts an io.EOF before the end of the declared
+// data into io.ErrUnexpectedEOF.
+func noEOF(err error) error {
+	if err == io.EOF {
+		return io.ErrUnexpectedEOF
+	}
+	return err
+}
+
+// Reset returns the stream to its first image.
+func (r *StreamReader) Reset() error {
+	_, err := r.images.Seek(0, io.SeekStart)
+	if err != nil {
+		return err
+	}
+	_, err = r.labels.Seek(0, io.SeekStart)
+	if err != nil {
+		return err
+	}
+	return r.start()
+}
+
+// Close closes the underlying files.
+func (r *StreamReader) Close() error {
+	err := r.images.Close()
+	lerr := r.labels.Close()
+	if err != nil {
+		return err
+	}
+	return lerr
+}
@@ -0,0 +1,85 @@
+// Copyright ©2026 The bíogo.nn Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mnist
+
+import (
+	"bytes"
+	"compress/gzip"
+	"io"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+// writeGzipIDX writes the images and labels of s to gzipped IDX
+// files in dir and returns their paths.
+func writeGzipIDX(t *testing.T, s Set, dir string) (images, labels string) {
+	images = filepath.Join(dir, "images.gz")
+	labels = filepath.Join(dir, "labels.gz")
+	for _, f := range []struct {
+		path   string
+		encode func(io.Writer) error
+	}{
+		{path: images, encode: s.encodeImages},
+		{path: labels, encode: s.encodeLabels},
+	} {
+		w, err := os.Create(f.path)
+		if err != nil {
+			t.Fatalf("Unexpected error creating %s: %v", f.path, err)
+		}
+		z := gzip.NewWriter(w)
+		err = f.encode(z)
+		if err != nil {
+			t.Fatalf("Unexpected error encoding %s: %v", f.path, err)
+		}
+		err = z.Close()
+		if err != nil {
+			t.Fatalf("Unexpected error compressing %s: %v", f.path, err)
+		}
+		err = w.Close()
+		if err != nil {
+			t.Fatalf("Unexpected error closing %s: %v", f.path, err)
+		}
+	}
+	return images, labels
+}
+
+func TestStreamReader(t *testing.T) {
+	images, labels := writeGzipIDX(t, Test, t.TempDir())
+	r, err := NewStreamReader(images, labels)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	defer r.Close()
+	if r.Len() != Test.Len() || r.Rows() != Test.Rows() || r.Cols() != Test.Cols() {
+		t.Fatalf("Unexpected shape: got: %d×%d×%d want: %d×%d×%d",
+			r.Len(), r.Rows(), r.Cols(), Test.Len(), Test.Rows(), Test.Cols())
+	}
+
+	for pass := 0; pass < 2; pass++ {
+		var n int
+		for {
+			label, img, err := r.Next()
+			if err == io.EOF {
+				break
+			}
+			if err != nil {
+				t.Fatalf("Unexpected error on pass %d at %d: %v", pass, n, err)
+			}
+			wantLabel, wantImg := Test.Index(n)
+			if label != wantLabel || !bytes.Equal(img, wantImg) {
+				t.Fatalf("Unexpected sample on pass %d at %d", pass, n)
+			}
+			n++
+		}
+		if n != Test.Len() {
+			t.Errorf("Unexpected number of samples on pass %d: got: %d want: %d", pass, n, Test.Len())
+		}
+		err = r.Reset()
+		if err != nil {
+			t.Fatalf("Unexpected error resetting: %v", err)
+		}
+	}
+}
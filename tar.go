@@ -0,0 +1,130 @@
+// Copyright ©2026 The bíogo.nn Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mnist
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"fmt"
+	"image/png"
+	"io"
+	"os"
+	pathpkg "path"
+	"strings"
+)
+
+// WriteTar writes each image of the data set as a grayscale PNG to a tar
+// archive at path. Entries are named by formatting the image index and label
+// with format, for example "%06d_%d.png". The archive is gzip compressed if
+// path ends in ".tar.gz" or ".tgz".
+func (s *Set) WriteTar(path, format string) error {
+	f, err := os.Create(path)
+	if err != nil {
+		return err
+	}
+	err = s.writeTar(f, format, isGzipTar(path))
+	if err != nil {
+		f.Close()
+		return err
+	}
+	return f.Close()
+}
+
+// isGzipTar returns whether path names a gzip compressed tar archive.
+func isGzipTar(path string) bool {
+	return strings.HasSuffix(path, ".tar.gz") || strings.HasSuffix(path, ".tgz")
+}
+
+func (s *Set) writeTar(w io.Writer, format string, compress bool) error {
+	var z *gzip.Writer
+	if compress {
+		z = gzip.NewWriter(w)
+		w = z
+	}
+	t := tar.NewWriter(w)
+	var buf bytes.Buffer
+	for i := 0; i < s.Len(); i++ {
+		buf.Reset()
+		err := s.WritePNG(i, &buf)
+		if err != nil {
+			return err
+		}
+		label, _ := s.Index(i)
+		err = t.WriteHeader(&tar.Header{
+			Typeflag: tar.TypeReg,
+			Name:     fmt.Sprintf(format, i, label),
+			Mode:     0o644,
+			Size:     int64(buf.Len()),
+		})
+		if err != nil {
+			return err
+		}
+		_, err = t.Write(buf.Bytes())
+		if err != nil {
+			return err
+		}
+	}
+	err := t.Close()
+	if err != nil {
+		return err
+	}
+	if z != nil {
+		return z.Close()
+	}
+	return nil
+}
+
+// ReadFromTar returns a Set constructed from the PNG entries of the tar
+// archive at path, which is read as gzip compressed if path ends in ".tar.gz"
+// or ".tgz". Images are read in archive order and converted to grayscale. The
+// label for each image is obtained by calling labelFromName with the base name
+// of the entry. All images must have the same dimensions.
+func ReadFromTar(path string, labelFromName func(filename string) (byte, error)) (Set, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return Set{}, err
+	}
+	defer f.Close()
+	var r io.Reader = f
+	if isGzipTar(path) {
+		z, err := gzip.NewReader(f)
+		if err != nil {
+			return Set{}, err
+		}
+		defer z.Close()
+		r = z
+	}
+
+	var s Set
+	t := tar.NewReader(r)
+	for {
+		h, err := t.Next()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return Set{}, err
+		}
+		name := pathpkg.Base(h.Name)
+		if h.Typeflag != tar.TypeReg || !strings.EqualFold(pathpkg.Ext(name), ".png") {
+			continue
+		}
+		label, err := labelFromName(name)
+		if err != nil {
+			return Set{}, fmt.Errorf("mnist: %s: %v", h.Name, err)
+		}
+		img, err := png.Decode(t)
+		if err != nil {
+			return Set{}, fmt.Errorf("mnist: %s: %v", h.Name, err)
+		}
+		err = s.appendGray(label, img)
+		if err != nil {
+			return Set{}, fmt.Errorf("mnist: %s: %v", h.Name, err)
+		}
+	}
+
+	return s, nil
+}
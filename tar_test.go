@@ -0,0 +1,148 @@
+// Copyright ©2026 The bíogo.nn Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mnist
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"fmt"
+	"image"
+	"image/png"
+	"io"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"testing"
+)
+
+func TestWriteTar(t *testing.T) {
+	s := subset(Test, 200)
+	for _, name := range []string{"mnist.tar", "mnist.tar.gz"} {
+		path := filepath.Join(t.TempDir(), name)
+		err := s.WriteTar(path, "%06d_%d.png")
+		if err != nil {
+			t.Fatalf("Unexpected error writing %s: %v", name, err)
+		}
+
+		f, err := os.Open(path)
+		if err != nil {
+			t.Fatalf("Unexpected error opening %s: %v", name, err)
+		}
+		var r io.Reader = f
+		if isGzipTar(path) {
+			z, err := gzip.NewReader(f)
+			if err != nil {
+				t.Fatalf("Unexpected error opening gzip stream of %s: %v", name, err)
+			}
+			r = z
+		}
+		tr := tar.NewReader(r)
+		var n int
+		for {
+			h, err := tr.Next()
+			if err == io.EOF {
+				break
+			}
+			if err != nil {
+				t.Fatalf("Unexpected error reading %s: %v", name, err)
+			}
+			label, img := s.Index(n)
+			if want := fmt.Sprintf("%06d_%d.png", n, label); h.Name != want {
+				t.Errorf("Unexpected entry name in %s: got: %q want: %q", name, h.Name, want)
+			}
+			m, err := png.Decode(tr)
+			if err != nil {
+				t.Fatalf("Invalid PNG entry %q in %s: %v", h.Name, name, err)
+			}
+			if b := m.Bounds(); b.Dx() != s.Cols() || b.Dy() != s.Rows() {
+				t.Errorf("Unexpected dimensions for %q in %s: got: %d×%d", h.Name, name, b.Dy(), b.Dx())
+			}
+			gray, ok := m.(*image.Gray)
+			if !ok {
+				t.Fatalf("Unexpected image type for %q in %s: got: %T want: *image.Gray", h.Name, name, m)
+			}
+			if !bytes.Equal(gray.Pix, img) {
+				t.Errorf("Unexpected pixels for %q in %s", h.Name, name)
+			}
+			n++
+		}
+		f.Close()
+		if n != s.Len() {
+			t.Errorf("Unexpected number of entries in %s: got: %d want: %d", name, n, s.Len())
+		}
+	}
+}
+
+func TestReadFromTar(t *testing.T) {
+	s := subset(Test, 500)
+	labelFromName := func(name string) (byte, error) {
+		_, label, ok := strings.Cut(strings.TrimSuffix(name, ".png"), "_")
+		if !ok {
+			return 0, fmt.Errorf("invalid file name: %q", name)
+		}
+		l, err := strconv.ParseUint(label, 10, 8)
+		return byte(l), err
+	}
+	for _, name := range []string{"mnist.tar", "mnist.tar.gz"} {
+		path := filepath.Join(t.TempDir(), name)
+		err := s.WriteTar(path, "digits/%06d_%d.png")
+		if err != nil {
+			t.Fatalf("Unexpected error writing %s: %v", name, err)
+		}
+		got, err := ReadFromTar(path, labelFromName)
+		if err != nil {
+			t.Fatalf("Unexpected error reading %s: %v", name, err)
+		}
+		if got.Len() != s.Len() || got.Rows() != s.Rows() || got.Cols() != s.Cols() {
+			t.Fatalf("Unexpected shape for %s: got: %d×%d×%d want: %d×%d×%d",
+				name, got.Len(), got.Rows(), got.Cols(), s.Len(), s.Rows(), s.Cols())
+		}
+		if !bytes.Equal(got.labels, s.labels) {
+			t.Errorf("Label mismatch after round-trip for %s", name)
+		}
+		if !bytes.Equal(got.matrix, s.matrix) {
+			t.Errorf("Pixel mismatch after round-trip for %s", name)
+		}
+	}
+}
+
+func TestReadFromTarMismatch(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "mismatch.tar")
+	f, err := os.Create(path)
+	if err != nil {
+		t.Fatalf("Unexpected error creating archive: %v", err)
+	}
+	tw := tar.NewWriter(f)
+	for i, img := range []image.Image{
+		image.NewGray(image.Rect(0, 0, 28, 28)),
+		image.NewGray(image.Rect(0, 0, 14, 28)),
+	} {
+		var buf bytes.Buffer
+		err = png.Encode(&buf, img)
+		if err != nil {
+			t.Fatalf("Unexpected error encoding image: %v", err)
+		}
+		err = tw.WriteHeader(&tar.Header{Name: fmt.Sprintf("%06d_0.png", i), Mode: 0o644, Size: int64(buf.Len())})
+		if err != nil {
+			t.Fatalf("Unexpected error writing header: %v", err)
+		}
+		_, err = tw.Write(buf.Bytes())
+		if err != nil {
+			t.Fatalf("Unexpected error writing image: %v", err)
+		}
+	}
+	err = tw.Close()
+	if err != nil {
+		t.Fatalf("Unexpected error closing archive: %v", err)
+	}
+	f.Close()
+
+	_, err = ReadFromTar(path, func(string) (byte, error) { return 0, nil })
+	if err == nil {
+		t.Error("Expected error for mismatched image dimensions")
+	}
+}
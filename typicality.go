@@ -0,0 +1,112 @@
+// Copyright ©2026 The bíogo.nn Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mnist
+
+import (
+	"math"
+	"sort"
+)
+
+// DistanceToMean returns the Euclidean distance between the i'th image of the
+// data set and the mean image, with pixel values scaled to [0,1]. If classOnly
+// is true, the mean is taken over the images with the same label as the i'th
+// image, otherwise it is taken over all the images of the data set.
+//
+// The mean image is recomputed on each call, which takes time proportional to
+// the total number of pixels in the data set, so calling DistanceToMean for
+// every image takes time quadratic in the number of images. MostTypical and
+// MostAtypical compute the class mean once when ranking the images of a class.
+func (s *Set) DistanceToMean(i int, classOnly bool) float64 {
+	var mean []float64
+	if classOnly {
+		label, _ := s.Index(i)
+		mean = s.classMean(label)
+	} else {
+		mean = s.pixelMeans()
+	}
+	return s.distanceTo(i, mean)
+}
+
+// MostTypical returns the indices of the n images with the given label that are
+// closest to the mean image of that class, as measured by DistanceToMean with
+// classOnly true, in order of increasing distance. If there are fewer than n
+// images with the label, all of their indices are returned. MostTypical panics
+// if n is negative.
+func (s *Set) MostTypical(label byte, n int) []int {
+	if n < 0 {
+		panic("mnist: negative sample count")
+	}
+	idx, dist := s.classDistances(label)
+	sort.SliceStable(idx, func(i, j int) bool {
+		return dist[idx[i]] < dist[idx[j]]
+	})
+	n = min(n, len(idx))
+	return idx[:n:n]
+}
+
+// MostAtypical returns the indices of the n images with the given label that
+// are furthest from the mean image of that class, as measured by DistanceToMean
+// with classOnly true, in order of decreasing distance. If there are fewer than
+// n images with the label, all of their indices are returned. MostAtypical
+// panics if n is negative.
+func (s *Set) MostAtypical(label byte, n int) []int {
+	if n < 0 {
+		panic("mnist: negative sample count")
+	}
+	idx, dist := s.classDistances(label)
+	sort.SliceStable(idx, func(i, j int) bool {
+		return dist[idx[i]] > dist[idx[j]]
+	})
+	n = min(n, len(idx))
+	return idx[:n:n]
+}
+
+// classDistances returns the indices of the images of the data set with the
+// given label and a slice holding the distance of each of those images from
+// the class mean at its index.
+func (s *Set) classDistances(label byte) (idx []int, dist []float64) {
+	mean := s.classMean(label)
+	dist = make([]float64, s.Len())
+	for i, l := range s.labels {
+		if l == label {
+			idx = append(idx, i)
+			dist[i] = s.distanceTo(i, mean)
+		}
+	}
+	return idx, dist
+}
+
+// classMean returns the mean value of each pixel position over the
+// images of the data set with the given label.
+func (s *Set) classMean(label byte) []float64 {
+	mean := make([]float64, s.Rows()*s.Cols())
+	var n int
+	for i, l := range s.labels {
+		if l != label {
+			continue
+		}
+		n++
+		_, img := s.Index(i)
+		for j, v := range img {
+			mean[j] += float64(v)
+		}
+	}
+	for j := range mean {
+		mean[j] /= float64(n)
+	}
+	return mean
+}
+
+// distanceTo returns the Euclidean distance between the i'th image
+// of the data set and mean, with both scaled from [0,255] to [0,1].
+func (s *Set) distanceTo(i int, mean []float64) float64 {
+	_, img := s.Index(i)
+	var sum float64
+	for j, v := range img {
+		d := (float64(v) - mean[j]) / 255
+		sum += d * d
+	}
+	return math.Sqrt(sum)
+}
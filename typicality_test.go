@@ -0,0 +1,119 @@
+// Copyright ©2026 The bíogo.nn Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mnist
+
+import (
+	"math"
+	"testing"
+)
+
+func TestDistanceToMean(t *testing.T) {
+	const dim = 4
+	a := []byte{0, 10, 100, 200}
+	b := []byte{20, 30, 50, 0}
+	mid := []byte{10, 20, 75, 100}
+	other := []byte{255, 255, 255, 255}
+	s, err := NewSet([][]byte{a, b, mid, other}, []byte{1, 1, 1, 2}, 1, dim)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if d := s.DistanceToMean(2, true); d != 0 {
+		t.Errorf("Unexpected distance to class mean for mean image: got: %v want: 0", d)
+	}
+	if d := s.DistanceToMean(3, true); d != 0 {
+		t.Errorf("Unexpected distance to class mean for singleton class: got: %v want: 0", d)
+	}
+	var want float64
+	for j := 0; j < dim; j++ {
+		mean := (float64(a[j]) + float64(b[j]) + float64(mid[j]) + float64(other[j])) / 4
+		d := (float64(a[j]) - mean) / 255
+		want += d * d
+	}
+	want = math.Sqrt(want)
+	if d := s.DistanceToMean(0, false); math.Abs(d-want) > 1e-12 {
+		t.Errorf("Unexpected distance to global mean: got: %v want: %v", d, want)
+	}
+
+	digits := subset(Test, 300)
+	for _, i := range []int{0, 1, 17, 299} {
+		label, img := digits.Index(i)
+		mean := make([]float64, len(img))
+		var n int
+		for k, l := range digits.labels {
+			if l != label {
+				continue
+			}
+			n++
+			_, other := digits.Index(k)
+			for j, v := range other {
+				mean[j] += float64(v) / 255
+			}
+		}
+		var want float64
+		for j, v := range img {
+			d := float64(v)/255 - mean[j]/float64(n)
+			want += d * d
+		}
+		want = math.Sqrt(want)
+		got := digits.DistanceToMean(i, true)
+		if math.Abs(got-want) > 1e-9 {
+			t.Errorf("Unexpected distance to class %d mean for image %d: got: %v want: %v", label, i, got, want)
+		}
+	}
+}
+
+func TestMostTypical(t *testing.T) {
+	s := subset(Test, 500)
+	counts := s.labelCounts()
+	for label := byte(0); label < numClasses; label++ {
+		for _, n := range []int{0, 1, 5, counts[label], counts[label] + 10} {
+			got := s.MostTypical(label, n)
+			if want := min(n, counts[label]); len(got) != want {
+				t.Errorf("Unexpected number of samples for label %d n=%d: got: %d want: %d", label, n, len(got), want)
+			}
+			prev := -1.0
+			for _, i := range got {
+				if l, _ := s.Index(i); l != label {
+					t.Errorf("Unexpected label for index %d: got: %d want: %d", i, l, label)
+				}
+				d := s.DistanceToMean(i, true)
+				if d < prev {
+					t.Errorf("Samples for label %d not ordered by distance: %v < %v", label, d, prev)
+				}
+				prev = d
+			}
+		}
+	}
+}
+
+func TestMostAtypical(t *testing.T) {
+	s := subset(Test, 500)
+	counts := s.labelCounts()
+	for label := byte(0); label < numClasses; label++ {
+		k := (counts[label] - 1) / 2
+		atypical := s.MostAtypical(label, k)
+		if len(atypical) != k {
+			t.Errorf("Unexpected number of samples for label %d: got: %d want: %d", label, len(atypical), k)
+		}
+		seen := make(map[int]bool)
+		prev := math.Inf(1)
+		for _, i := range atypical {
+			if l, _ := s.Index(i); l != label {
+				t.Errorf("Unexpected label for index %d: got: %d want: %d", i, l, label)
+			}
+			d := s.DistanceToMean(i, true)
+			if d > prev {
+				t.Errorf("Samples for label %d not ordered by decreasing distance: %v > %v", label, d, prev)
+			}
+			prev = d
+			seen[i] = true
+		}
+		for _, i := range s.MostTypical(label, k) {
+			if seen[i] {
+				t.Errorf("Index %d for label %d returned as both typical and atypical", i, label)
+			}
+		}
+	}
+}
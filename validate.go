@@ -0,0 +1,38 @@
+// Copyright ©2026 The bíogo.nn Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mnist
+
+import "fmt"
+
+// Validate checks the internal consistency of the data set. It returns an
+// error describing the first inconsistency found, or nil if the data set
+// is valid. A valid Set has positive image dimensions, a non-negative count,
+// image data of length count*rows*cols, count labels and labels that are all
+// digit classes in [0, 9].
+//
+// The IDX magic numbers are checked when data is decoded and are not held
+// by a Set, so they are not checked by Validate.
+func (s *Set) Validate() error {
+	if s.rows <= 0 || s.cols <= 0 {
+		return fmt.Errorf("mnist: invalid image dimensions: %d×%d", s.rows, s.cols)
+	}
+	if s.count < 0 {
+		return fmt.Errorf("mnist: invalid image count: %d", s.count)
+	}
+	want := int64(s.count) * int64(s.rows) * int64(s.cols)
+	if int64(len(s.matrix)) != want {
+		return fmt.Errorf("mnist: image data length mismatch: got: %d want: %d (%d×%d×%d)",
+			len(s.matrix), want, s.count, s.rows, s.cols)
+	}
+	if len(s.labels) != int(s.count) {
+		return fmt.Errorf("mnist: label count mismatch: got: %d want: %d", len(s.labels), s.count)
+	}
+	for i, l := range s.labels {
+		if l >= numClasses {
+			return fmt.Errorf("mnist: invalid label at %d: %d", i, l)
+		}
+	}
+	return nil
+}
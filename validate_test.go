@@ -0,0 +1,78 @@
+// Copyright ©2026 The bíogo.nn Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mnist
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestValidate(t *testing.T) {
+	for _, s := range []*Set{&Train, &Test} {
+		err := s.Validate()
+		if err != nil {
+			t.Errorf("Unexpected error for valid set: %v", err)
+		}
+	}
+
+	for _, test := range []struct {
+		name   string
+		mutate func(s *Set)
+		want   string
+	}{
+		{
+			name:   "short matrix",
+			mutate: func(s *Set) { s.matrix = s.matrix[:len(s.matrix)-1] },
+			want:   "image data length",
+		},
+		{
+			name:   "short labels",
+			mutate: func(s *Set) { s.labels = s.labels[:len(s.labels)-1] },
+			want:   "label count",
+		},
+		{
+			name:   "count",
+			mutate: func(s *Set) { s.count++ },
+			want:   "image data length",
+		},
+		{
+			name:   "negative count",
+			mutate: func(s *Set) { s.count = -1 },
+			want:   "image count",
+		},
+		{
+			name:   "zero rows",
+			mutate: func(s *Set) { s.rows = 0 },
+			want:   "image dimensions",
+		},
+		{
+			name:   "zero cols",
+			mutate: func(s *Set) { s.cols = 0 },
+			want:   "image dimensions",
+		},
+		{
+			name:   "transposed dimensions",
+			mutate: func(s *Set) { s.rows, s.cols = s.rows*2, s.cols/2+1 },
+			want:   "image data length",
+		},
+		{
+			name:   "label",
+			mutate: func(s *Set) { s.labels[5] = 10 },
+			want:   "invalid label at 5",
+		},
+	} {
+		s := subset(Test, 20)
+		s.labels = s.Labels()
+		test.mutate(&s)
+		err := s.Validate()
+		if err == nil {
+			t.Errorf("Expected error for %s", test.name)
+			continue
+		}
+		if !strings.Contains(err.Error(), test.want) {
+			t.Errorf("Unexpected error for %s: got: %q want message containing %q", test.name, err, test.want)
+		}
+	}
+}
@@ -0,0 +1,94 @@
+// Copyright ©2026 The bíogo.nn Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mnist
+
+import (
+	"archive/zip"
+	"fmt"
+	"io"
+	"os"
+)
+
+const (
+	zipImages = "images.idx"
+	zipLabels = "labels.idx"
+)
+
+// WriteZip writes the data set to a ZIP archive at path. The archive holds
+// the images and labels in IDX format in the entries "images.idx" and
+// "labels.idx" respectively.
+func (s *Set) WriteZip(path string) error {
+	f, err := os.Create(path)
+	if err != nil {
+		return err
+	}
+	err = s.writeZip(f)
+	if err != nil {
+		f.Close()
+		return err
+	}
+	return f.Close()
+}
+
+func (s *Set) writeZip(w io.Writer) error {
+	z := zip.NewWriter(w)
+	e, err := z.Create(zipImages)
+	if err != nil {
+		return err
+	}
+	err = s.encodeImages(e)
+	if err != nil {
+		return err
+	}
+	e, err = z.Create(zipLabels)
+	if err != nil {
+		return err
+	}
+	err = s.encodeLabels(e)
+	if err != nil {
+		return err
+	}
+	return z.Close()
+}
+
+// ReadFromZip returns a Set read from a ZIP archive at path written by WriteZip.
+func ReadFromZip(path string) (Set, error) {
+	z, err := zip.OpenReader(path)
+	if err != nil {
+		return Set{}, err
+	}
+	defer z.Close()
+
+	var s Set
+	r, err := openZipEntry(z, zipImages)
+	if err != nil {
+		return Set{}, err
+	}
+	err = s.decodeImages(r)
+	r.Close()
+	if err != nil {
+		return Set{}, err
+	}
+	r, err = openZipEntry(z, zipLabels)
+	if err != nil {
+		return Set{}, err
+	}
+	err = s.decodeLabels(r)
+	r.Close()
+	if err != nil {
+		return Set{}, err
+	}
+
+	return s, nil
+}
+
+func openZipEntry(z *zip.ReadCloser, name string) (io.ReadCloser, error) {
+	for _, f := range z.File {
+		if f.Name == name {
+			return f.Open()
+		}
+	}
+	return nil, fmt.Errorf("mnist: missing %s in archive", name)
+}
@@ -0,0 +1,48 @@
+// Copyright ©2026 The bíogo.nn Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mnist
+
+import (
+	"archive/zip"
+	"bytes"
+	"path/filepath"
+	"testing"
+)
+
+func TestZip(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "test.zip")
+	err := Test.WriteZip(path)
+	if err != nil {
+		t.Fatalf("Unexpected error writing archive: %v", err)
+	}
+
+	z, err := zip.OpenReader(path)
+	if err != nil {
+		t.Fatalf("Unexpected error opening archive: %v", err)
+	}
+	var names []string
+	for _, f := range z.File {
+		names = append(names, f.Name)
+	}
+	z.Close()
+	if len(names) != 2 || names[0] != "images.idx" || names[1] != "labels.idx" {
+		t.Errorf("Unexpected archive entries: got: %q want: %q", names, []string{"images.idx", "labels.idx"})
+	}
+
+	got, err := ReadFromZip(path)
+	if err != nil {
+		t.Fatalf("Unexpected error reading archive: %v", err)
+	}
+	if got.count != Test.count || got.rows != Test.rows || got.cols != Test.cols {
+		t.Errorf("Unexpected shape: got: %d×%d×%d want: %d×%d×%d",
+			got.count, got.rows, got.cols, Test.count, Test.rows, Test.cols)
+	}
+	if !bytes.Equal(got.matrix, Test.matrix) {
+		t.Error("Pixel mismatch after round-trip")
+	}
+	if !bytes.Equal(got.labels, Test.labels) {
+		t.Error("Label mismatch after round-trip")
+	}
+}